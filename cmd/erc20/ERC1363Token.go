@@ -0,0 +1,895 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package main
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC1363TokenMetaData contains all meta data concerning the ERC1363Token contract.
+var ERC1363TokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"initialSupply\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ERC1363ApproveFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC1363InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC1363InvalidSpender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ERC1363TransferFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"ERC1363TransferFromFailed\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approveAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"approveAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"transferAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFromAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"transferFromAndCall\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ERC1363TokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC1363TokenMetaData.ABI instead.
+var ERC1363TokenABI = ERC1363TokenMetaData.ABI
+
+// ERC1363Token is an auto generated Go binding around an Ethereum contract.
+type ERC1363Token struct {
+	ERC1363TokenCaller     // Read-only binding to the contract
+	ERC1363TokenTransactor // Write-only binding to the contract
+	ERC1363TokenFilterer   // Log filterer for contract events
+}
+
+// ERC1363TokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC1363TokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1363TokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC1363TokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1363TokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC1363TokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1363TokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC1363TokenSession struct {
+	Contract     *ERC1363Token     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC1363TokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC1363TokenCallerSession struct {
+	Contract *ERC1363TokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ERC1363TokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC1363TokenTransactorSession struct {
+	Contract     *ERC1363TokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ERC1363TokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC1363TokenRaw struct {
+	Contract *ERC1363Token // Generic contract binding to access the raw methods on
+}
+
+// ERC1363TokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC1363TokenCallerRaw struct {
+	Contract *ERC1363TokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC1363TokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC1363TokenTransactorRaw struct {
+	Contract *ERC1363TokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC1363Token creates a new instance of ERC1363Token, bound to a specific deployed contract.
+func NewERC1363Token(address common.Address, backend bind.ContractBackend) (*ERC1363Token, error) {
+	contract, err := bindERC1363Token(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363Token{ERC1363TokenCaller: ERC1363TokenCaller{contract: contract}, ERC1363TokenTransactor: ERC1363TokenTransactor{contract: contract}, ERC1363TokenFilterer: ERC1363TokenFilterer{contract: contract}}, nil
+}
+
+// NewERC1363TokenCaller creates a new read-only instance of ERC1363Token, bound to a specific deployed contract.
+func NewERC1363TokenCaller(address common.Address, caller bind.ContractCaller) (*ERC1363TokenCaller, error) {
+	contract, err := bindERC1363Token(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363TokenCaller{contract: contract}, nil
+}
+
+// NewERC1363TokenTransactor creates a new write-only instance of ERC1363Token, bound to a specific deployed contract.
+func NewERC1363TokenTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC1363TokenTransactor, error) {
+	contract, err := bindERC1363Token(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363TokenTransactor{contract: contract}, nil
+}
+
+// NewERC1363TokenFilterer creates a new log filterer instance of ERC1363Token, bound to a specific deployed contract.
+func NewERC1363TokenFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC1363TokenFilterer, error) {
+	contract, err := bindERC1363Token(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363TokenFilterer{contract: contract}, nil
+}
+
+// bindERC1363Token binds a generic wrapper to an already deployed contract.
+func bindERC1363Token(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC1363TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1363Token *ERC1363TokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1363Token.Contract.ERC1363TokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1363Token *ERC1363TokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ERC1363TokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1363Token *ERC1363TokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ERC1363TokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1363Token *ERC1363TokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1363Token.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1363Token *ERC1363TokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1363Token *ERC1363TokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC1363Token.Contract.Allowance(&_ERC1363Token.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC1363Token.Contract.Allowance(&_ERC1363Token.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC1363Token.Contract.BalanceOf(&_ERC1363Token.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC1363Token.Contract.BalanceOf(&_ERC1363Token.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC1363Token *ERC1363TokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC1363Token *ERC1363TokenSession) Decimals() (uint8, error) {
+	return _ERC1363Token.Contract.Decimals(&_ERC1363Token.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC1363Token *ERC1363TokenCallerSession) Decimals() (uint8, error) {
+	return _ERC1363Token.Contract.Decimals(&_ERC1363Token.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC1363Token *ERC1363TokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC1363Token *ERC1363TokenSession) Name() (string, error) {
+	return _ERC1363Token.Contract.Name(&_ERC1363Token.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC1363Token *ERC1363TokenCallerSession) Name() (string, error) {
+	return _ERC1363Token.Contract.Name(&_ERC1363Token.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1363Token *ERC1363TokenCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1363Token.Contract.SupportsInterface(&_ERC1363Token.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC1363Token *ERC1363TokenCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC1363Token.Contract.SupportsInterface(&_ERC1363Token.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC1363Token *ERC1363TokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC1363Token *ERC1363TokenSession) Symbol() (string, error) {
+	return _ERC1363Token.Contract.Symbol(&_ERC1363Token.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC1363Token *ERC1363TokenCallerSession) Symbol() (string, error) {
+	return _ERC1363Token.Contract.Symbol(&_ERC1363Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC1363Token.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC1363Token *ERC1363TokenSession) TotalSupply() (*big.Int, error) {
+	return _ERC1363Token.Contract.TotalSupply(&_ERC1363Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC1363Token *ERC1363TokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC1363Token.Contract.TotalSupply(&_ERC1363Token.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.Approve(&_ERC1363Token.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.Approve(&_ERC1363Token.TransactOpts, spender, value)
+}
+
+// ApproveAndCall is a paid mutator transaction binding the contract method 0x3177029f.
+//
+// Solidity: function approveAndCall(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) ApproveAndCall(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "approveAndCall", spender, value)
+}
+
+// ApproveAndCall is a paid mutator transaction binding the contract method 0x3177029f.
+//
+// Solidity: function approveAndCall(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) ApproveAndCall(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ApproveAndCall(&_ERC1363Token.TransactOpts, spender, value)
+}
+
+// ApproveAndCall is a paid mutator transaction binding the contract method 0x3177029f.
+//
+// Solidity: function approveAndCall(address spender, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) ApproveAndCall(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ApproveAndCall(&_ERC1363Token.TransactOpts, spender, value)
+}
+
+// ApproveAndCall0 is a paid mutator transaction binding the contract method 0xcae9ca51.
+//
+// Solidity: function approveAndCall(address spender, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) ApproveAndCall0(opts *bind.TransactOpts, spender common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "approveAndCall0", spender, value, data)
+}
+
+// ApproveAndCall0 is a paid mutator transaction binding the contract method 0xcae9ca51.
+//
+// Solidity: function approveAndCall(address spender, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) ApproveAndCall0(spender common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ApproveAndCall0(&_ERC1363Token.TransactOpts, spender, value, data)
+}
+
+// ApproveAndCall0 is a paid mutator transaction binding the contract method 0xcae9ca51.
+//
+// Solidity: function approveAndCall(address spender, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) ApproveAndCall0(spender common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.ApproveAndCall0(&_ERC1363Token.TransactOpts, spender, value, data)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.Transfer(&_ERC1363Token.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.Transfer(&_ERC1363Token.TransactOpts, to, value)
+}
+
+// TransferAndCall is a paid mutator transaction binding the contract method 0x1296ee62.
+//
+// Solidity: function transferAndCall(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) TransferAndCall(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transferAndCall", to, value)
+}
+
+// TransferAndCall is a paid mutator transaction binding the contract method 0x1296ee62.
+//
+// Solidity: function transferAndCall(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) TransferAndCall(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferAndCall(&_ERC1363Token.TransactOpts, to, value)
+}
+
+// TransferAndCall is a paid mutator transaction binding the contract method 0x1296ee62.
+//
+// Solidity: function transferAndCall(address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) TransferAndCall(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferAndCall(&_ERC1363Token.TransactOpts, to, value)
+}
+
+// TransferAndCall0 is a paid mutator transaction binding the contract method 0x4000aea0.
+//
+// Solidity: function transferAndCall(address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) TransferAndCall0(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transferAndCall0", to, value, data)
+}
+
+// TransferAndCall0 is a paid mutator transaction binding the contract method 0x4000aea0.
+//
+// Solidity: function transferAndCall(address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) TransferAndCall0(to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferAndCall0(&_ERC1363Token.TransactOpts, to, value, data)
+}
+
+// TransferAndCall0 is a paid mutator transaction binding the contract method 0x4000aea0.
+//
+// Solidity: function transferAndCall(address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) TransferAndCall0(to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferAndCall0(&_ERC1363Token.TransactOpts, to, value, data)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFrom(&_ERC1363Token.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFrom(&_ERC1363Token.TransactOpts, from, to, value)
+}
+
+// TransferFromAndCall is a paid mutator transaction binding the contract method 0xd8fbe994.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) TransferFromAndCall(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transferFromAndCall", from, to, value)
+}
+
+// TransferFromAndCall is a paid mutator transaction binding the contract method 0xd8fbe994.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) TransferFromAndCall(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFromAndCall(&_ERC1363Token.TransactOpts, from, to, value)
+}
+
+// TransferFromAndCall is a paid mutator transaction binding the contract method 0xd8fbe994.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) TransferFromAndCall(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFromAndCall(&_ERC1363Token.TransactOpts, from, to, value)
+}
+
+// TransferFromAndCall0 is a paid mutator transaction binding the contract method 0xc1d34b89.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactor) TransferFromAndCall0(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.contract.Transact(opts, "transferFromAndCall0", from, to, value, data)
+}
+
+// TransferFromAndCall0 is a paid mutator transaction binding the contract method 0xc1d34b89.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenSession) TransferFromAndCall0(from common.Address, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFromAndCall0(&_ERC1363Token.TransactOpts, from, to, value, data)
+}
+
+// TransferFromAndCall0 is a paid mutator transaction binding the contract method 0xc1d34b89.
+//
+// Solidity: function transferFromAndCall(address from, address to, uint256 value, bytes data) returns(bool)
+func (_ERC1363Token *ERC1363TokenTransactorSession) TransferFromAndCall0(from common.Address, to common.Address, value *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC1363Token.Contract.TransferFromAndCall0(&_ERC1363Token.TransactOpts, from, to, value, data)
+}
+
+// ERC1363TokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC1363Token contract.
+type ERC1363TokenApprovalIterator struct {
+	Event *ERC1363TokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1363TokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1363TokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1363TokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1363TokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1363TokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1363TokenApproval represents a Approval event raised by the ERC1363Token contract.
+type ERC1363TokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ERC1363TokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC1363Token.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363TokenApprovalIterator{contract: _ERC1363Token.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC1363TokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC1363Token.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1363TokenApproval)
+				if err := _ERC1363Token.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) ParseApproval(log types.Log) (*ERC1363TokenApproval, error) {
+	event := new(ERC1363TokenApproval)
+	if err := _ERC1363Token.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC1363TokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC1363Token contract.
+type ERC1363TokenTransferIterator struct {
+	Event *ERC1363TokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1363TokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1363TokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1363TokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1363TokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1363TokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1363TokenTransfer represents a Transfer event raised by the ERC1363Token contract.
+type ERC1363TokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ERC1363TokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1363Token.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1363TokenTransferIterator{contract: _ERC1363Token.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC1363TokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC1363Token.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1363TokenTransfer)
+				if err := _ERC1363Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC1363Token *ERC1363TokenFilterer) ParseTransfer(log types.Log) (*ERC1363TokenTransfer, error) {
+	event := new(ERC1363TokenTransfer)
+	if err := _ERC1363Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1740 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package main
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ERC777TokenMetaData contains all meta data concerning the ERC777Token contract.
+var ERC777TokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"initialSupply\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"defaultOperators\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"tokenHolder\",\"type\":\"address\"}],\"name\":\"AuthorizedOperator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"operatorData\",\"type\":\"bytes\"}],\"name\":\"Burned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"operatorData\",\"type\":\"bytes\"}],\"name\":\"Minted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"tokenHolder\",\"type\":\"address\"}],\"name\":\"RevokedOperator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"operatorData\",\"type\":\"bytes\"}],\"name\":\"Sent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"authorizeOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultOperators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"granularity\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenHolder\",\"type\":\"address\"}],\"name\":\"isOperatorFor\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"operatorData\",\"type\":\"bytes\"}],\"name\":\"operatorBurn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"operatorData\",\"type\":\"bytes\"}],\"name\":\"operatorSend\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"revokeOperator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ERC777TokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC777TokenMetaData.ABI instead.
+var ERC777TokenABI = ERC777TokenMetaData.ABI
+
+// ERC777Token is an auto generated Go binding around an Ethereum contract.
+type ERC777Token struct {
+	ERC777TokenCaller     // Read-only binding to the contract
+	ERC777TokenTransactor // Write-only binding to the contract
+	ERC777TokenFilterer   // Log filterer for contract events
+}
+
+// ERC777TokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC777TokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC777TokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC777TokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC777TokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC777TokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC777TokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC777TokenSession struct {
+	Contract     *ERC777Token      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC777TokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC777TokenCallerSession struct {
+	Contract *ERC777TokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// ERC777TokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC777TokenTransactorSession struct {
+	Contract     *ERC777TokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// ERC777TokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC777TokenRaw struct {
+	Contract *ERC777Token // Generic contract binding to access the raw methods on
+}
+
+// ERC777TokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC777TokenCallerRaw struct {
+	Contract *ERC777TokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC777TokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC777TokenTransactorRaw struct {
+	Contract *ERC777TokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC777Token creates a new instance of ERC777Token, bound to a specific deployed contract.
+func NewERC777Token(address common.Address, backend bind.ContractBackend) (*ERC777Token, error) {
+	contract, err := bindERC777Token(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777Token{ERC777TokenCaller: ERC777TokenCaller{contract: contract}, ERC777TokenTransactor: ERC777TokenTransactor{contract: contract}, ERC777TokenFilterer: ERC777TokenFilterer{contract: contract}}, nil
+}
+
+// NewERC777TokenCaller creates a new read-only instance of ERC777Token, bound to a specific deployed contract.
+func NewERC777TokenCaller(address common.Address, caller bind.ContractCaller) (*ERC777TokenCaller, error) {
+	contract, err := bindERC777Token(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenCaller{contract: contract}, nil
+}
+
+// NewERC777TokenTransactor creates a new write-only instance of ERC777Token, bound to a specific deployed contract.
+func NewERC777TokenTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC777TokenTransactor, error) {
+	contract, err := bindERC777Token(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenTransactor{contract: contract}, nil
+}
+
+// NewERC777TokenFilterer creates a new log filterer instance of ERC777Token, bound to a specific deployed contract.
+func NewERC777TokenFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC777TokenFilterer, error) {
+	contract, err := bindERC777Token(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenFilterer{contract: contract}, nil
+}
+
+// bindERC777Token binds a generic wrapper to an already deployed contract.
+func bindERC777Token(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC777TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC777Token *ERC777TokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC777Token.Contract.ERC777TokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC777Token *ERC777TokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC777Token.Contract.ERC777TokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC777Token *ERC777TokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC777Token.Contract.ERC777TokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC777Token *ERC777TokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC777Token.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC777Token *ERC777TokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC777Token.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC777Token *ERC777TokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC777Token.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC777Token *ERC777TokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC777Token *ERC777TokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC777Token.Contract.Allowance(&_ERC777Token.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_ERC777Token *ERC777TokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _ERC777Token.Contract.Allowance(&_ERC777Token.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC777Token *ERC777TokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC777Token *ERC777TokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC777Token.Contract.BalanceOf(&_ERC777Token.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_ERC777Token *ERC777TokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _ERC777Token.Contract.BalanceOf(&_ERC777Token.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC777Token *ERC777TokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC777Token *ERC777TokenSession) Decimals() (uint8, error) {
+	return _ERC777Token.Contract.Decimals(&_ERC777Token.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_ERC777Token *ERC777TokenCallerSession) Decimals() (uint8, error) {
+	return _ERC777Token.Contract.Decimals(&_ERC777Token.CallOpts)
+}
+
+// DefaultOperators is a free data retrieval call binding the contract method 0x06e48538.
+//
+// Solidity: function defaultOperators() view returns(address[])
+func (_ERC777Token *ERC777TokenCaller) DefaultOperators(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "defaultOperators")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// DefaultOperators is a free data retrieval call binding the contract method 0x06e48538.
+//
+// Solidity: function defaultOperators() view returns(address[])
+func (_ERC777Token *ERC777TokenSession) DefaultOperators() ([]common.Address, error) {
+	return _ERC777Token.Contract.DefaultOperators(&_ERC777Token.CallOpts)
+}
+
+// DefaultOperators is a free data retrieval call binding the contract method 0x06e48538.
+//
+// Solidity: function defaultOperators() view returns(address[])
+func (_ERC777Token *ERC777TokenCallerSession) DefaultOperators() ([]common.Address, error) {
+	return _ERC777Token.Contract.DefaultOperators(&_ERC777Token.CallOpts)
+}
+
+// Granularity is a free data retrieval call binding the contract method 0x556f0dc7.
+//
+// Solidity: function granularity() view returns(uint256)
+func (_ERC777Token *ERC777TokenCaller) Granularity(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "granularity")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Granularity is a free data retrieval call binding the contract method 0x556f0dc7.
+//
+// Solidity: function granularity() view returns(uint256)
+func (_ERC777Token *ERC777TokenSession) Granularity() (*big.Int, error) {
+	return _ERC777Token.Contract.Granularity(&_ERC777Token.CallOpts)
+}
+
+// Granularity is a free data retrieval call binding the contract method 0x556f0dc7.
+//
+// Solidity: function granularity() view returns(uint256)
+func (_ERC777Token *ERC777TokenCallerSession) Granularity() (*big.Int, error) {
+	return _ERC777Token.Contract.Granularity(&_ERC777Token.CallOpts)
+}
+
+// IsOperatorFor is a free data retrieval call binding the contract method 0xd95b6371.
+//
+// Solidity: function isOperatorFor(address operator, address tokenHolder) view returns(bool)
+func (_ERC777Token *ERC777TokenCaller) IsOperatorFor(opts *bind.CallOpts, operator common.Address, tokenHolder common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "isOperatorFor", operator, tokenHolder)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperatorFor is a free data retrieval call binding the contract method 0xd95b6371.
+//
+// Solidity: function isOperatorFor(address operator, address tokenHolder) view returns(bool)
+func (_ERC777Token *ERC777TokenSession) IsOperatorFor(operator common.Address, tokenHolder common.Address) (bool, error) {
+	return _ERC777Token.Contract.IsOperatorFor(&_ERC777Token.CallOpts, operator, tokenHolder)
+}
+
+// IsOperatorFor is a free data retrieval call binding the contract method 0xd95b6371.
+//
+// Solidity: function isOperatorFor(address operator, address tokenHolder) view returns(bool)
+func (_ERC777Token *ERC777TokenCallerSession) IsOperatorFor(operator common.Address, tokenHolder common.Address) (bool, error) {
+	return _ERC777Token.Contract.IsOperatorFor(&_ERC777Token.CallOpts, operator, tokenHolder)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC777Token *ERC777TokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC777Token *ERC777TokenSession) Name() (string, error) {
+	return _ERC777Token.Contract.Name(&_ERC777Token.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC777Token *ERC777TokenCallerSession) Name() (string, error) {
+	return _ERC777Token.Contract.Name(&_ERC777Token.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC777Token *ERC777TokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC777Token *ERC777TokenSession) Symbol() (string, error) {
+	return _ERC777Token.Contract.Symbol(&_ERC777Token.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC777Token *ERC777TokenCallerSession) Symbol() (string, error) {
+	return _ERC777Token.Contract.Symbol(&_ERC777Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC777Token *ERC777TokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC777Token.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC777Token *ERC777TokenSession) TotalSupply() (*big.Int, error) {
+	return _ERC777Token.Contract.TotalSupply(&_ERC777Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_ERC777Token *ERC777TokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _ERC777Token.Contract.TotalSupply(&_ERC777Token.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Approve(&_ERC777Token.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Approve(&_ERC777Token.TransactOpts, spender, value)
+}
+
+// AuthorizeOperator is a paid mutator transaction binding the contract method 0x959b8c3f.
+//
+// Solidity: function authorizeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenTransactor) AuthorizeOperator(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "authorizeOperator", operator)
+}
+
+// AuthorizeOperator is a paid mutator transaction binding the contract method 0x959b8c3f.
+//
+// Solidity: function authorizeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenSession) AuthorizeOperator(operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.Contract.AuthorizeOperator(&_ERC777Token.TransactOpts, operator)
+}
+
+// AuthorizeOperator is a paid mutator transaction binding the contract method 0x959b8c3f.
+//
+// Solidity: function authorizeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) AuthorizeOperator(operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.Contract.AuthorizeOperator(&_ERC777Token.TransactOpts, operator)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xfe9d9303.
+//
+// Solidity: function burn(uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenTransactor) Burn(opts *bind.TransactOpts, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "burn", amount, data)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xfe9d9303.
+//
+// Solidity: function burn(uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenSession) Burn(amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Burn(&_ERC777Token.TransactOpts, amount, data)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xfe9d9303.
+//
+// Solidity: function burn(uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) Burn(amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Burn(&_ERC777Token.TransactOpts, amount, data)
+}
+
+// OperatorBurn is a paid mutator transaction binding the contract method 0xfc673c4f.
+//
+// Solidity: function operatorBurn(address account, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenTransactor) OperatorBurn(opts *bind.TransactOpts, account common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "operatorBurn", account, amount, data, operatorData)
+}
+
+// OperatorBurn is a paid mutator transaction binding the contract method 0xfc673c4f.
+//
+// Solidity: function operatorBurn(address account, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenSession) OperatorBurn(account common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.OperatorBurn(&_ERC777Token.TransactOpts, account, amount, data, operatorData)
+}
+
+// OperatorBurn is a paid mutator transaction binding the contract method 0xfc673c4f.
+//
+// Solidity: function operatorBurn(address account, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) OperatorBurn(account common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.OperatorBurn(&_ERC777Token.TransactOpts, account, amount, data, operatorData)
+}
+
+// OperatorSend is a paid mutator transaction binding the contract method 0x62ad1b83.
+//
+// Solidity: function operatorSend(address sender, address recipient, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenTransactor) OperatorSend(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "operatorSend", sender, recipient, amount, data, operatorData)
+}
+
+// OperatorSend is a paid mutator transaction binding the contract method 0x62ad1b83.
+//
+// Solidity: function operatorSend(address sender, address recipient, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenSession) OperatorSend(sender common.Address, recipient common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.OperatorSend(&_ERC777Token.TransactOpts, sender, recipient, amount, data, operatorData)
+}
+
+// OperatorSend is a paid mutator transaction binding the contract method 0x62ad1b83.
+//
+// Solidity: function operatorSend(address sender, address recipient, uint256 amount, bytes data, bytes operatorData) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) OperatorSend(sender common.Address, recipient common.Address, amount *big.Int, data []byte, operatorData []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.OperatorSend(&_ERC777Token.TransactOpts, sender, recipient, amount, data, operatorData)
+}
+
+// RevokeOperator is a paid mutator transaction binding the contract method 0xfad8b32a.
+//
+// Solidity: function revokeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenTransactor) RevokeOperator(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "revokeOperator", operator)
+}
+
+// RevokeOperator is a paid mutator transaction binding the contract method 0xfad8b32a.
+//
+// Solidity: function revokeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenSession) RevokeOperator(operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.Contract.RevokeOperator(&_ERC777Token.TransactOpts, operator)
+}
+
+// RevokeOperator is a paid mutator transaction binding the contract method 0xfad8b32a.
+//
+// Solidity: function revokeOperator(address operator) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) RevokeOperator(operator common.Address) (*types.Transaction, error) {
+	return _ERC777Token.Contract.RevokeOperator(&_ERC777Token.TransactOpts, operator)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x9bd9bbc6.
+//
+// Solidity: function send(address recipient, uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenTransactor) Send(opts *bind.TransactOpts, recipient common.Address, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "send", recipient, amount, data)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x9bd9bbc6.
+//
+// Solidity: function send(address recipient, uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenSession) Send(recipient common.Address, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Send(&_ERC777Token.TransactOpts, recipient, amount, data)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x9bd9bbc6.
+//
+// Solidity: function send(address recipient, uint256 amount, bytes data) returns()
+func (_ERC777Token *ERC777TokenTransactorSession) Send(recipient common.Address, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Send(&_ERC777Token.TransactOpts, recipient, amount, data)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Transfer(&_ERC777Token.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.Transfer(&_ERC777Token.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.TransferFrom(&_ERC777Token.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_ERC777Token *ERC777TokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _ERC777Token.Contract.TransferFrom(&_ERC777Token.TransactOpts, from, to, value)
+}
+
+// ERC777TokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC777Token contract.
+type ERC777TokenApprovalIterator struct {
+	Event *ERC777TokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenApproval represents a Approval event raised by the ERC777Token contract.
+type ERC777TokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*ERC777TokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenApprovalIterator{contract: _ERC777Token.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC777TokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenApproval)
+				if err := _ERC777Token.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) ParseApproval(log types.Log) (*ERC777TokenApproval, error) {
+	event := new(ERC777TokenApproval)
+	if err := _ERC777Token.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenAuthorizedOperatorIterator is returned from FilterAuthorizedOperator and is used to iterate over the raw logs and unpacked data for AuthorizedOperator events raised by the ERC777Token contract.
+type ERC777TokenAuthorizedOperatorIterator struct {
+	Event *ERC777TokenAuthorizedOperator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenAuthorizedOperatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenAuthorizedOperator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenAuthorizedOperator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenAuthorizedOperatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenAuthorizedOperatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenAuthorizedOperator represents a AuthorizedOperator event raised by the ERC777Token contract.
+type ERC777TokenAuthorizedOperator struct {
+	Operator    common.Address
+	TokenHolder common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterAuthorizedOperator is a free log retrieval operation binding the contract event 0xf4caeb2d6ca8932a215a353d0703c326ec2d81fc68170f320eb2ab49e9df61f9.
+//
+// Solidity: event AuthorizedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) FilterAuthorizedOperator(opts *bind.FilterOpts, operator []common.Address, tokenHolder []common.Address) (*ERC777TokenAuthorizedOperatorIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var tokenHolderRule []interface{}
+	for _, tokenHolderItem := range tokenHolder {
+		tokenHolderRule = append(tokenHolderRule, tokenHolderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "AuthorizedOperator", operatorRule, tokenHolderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenAuthorizedOperatorIterator{contract: _ERC777Token.contract, event: "AuthorizedOperator", logs: logs, sub: sub}, nil
+}
+
+// WatchAuthorizedOperator is a free log subscription operation binding the contract event 0xf4caeb2d6ca8932a215a353d0703c326ec2d81fc68170f320eb2ab49e9df61f9.
+//
+// Solidity: event AuthorizedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) WatchAuthorizedOperator(opts *bind.WatchOpts, sink chan<- *ERC777TokenAuthorizedOperator, operator []common.Address, tokenHolder []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var tokenHolderRule []interface{}
+	for _, tokenHolderItem := range tokenHolder {
+		tokenHolderRule = append(tokenHolderRule, tokenHolderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "AuthorizedOperator", operatorRule, tokenHolderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenAuthorizedOperator)
+				if err := _ERC777Token.contract.UnpackLog(event, "AuthorizedOperator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAuthorizedOperator is a log parse operation binding the contract event 0xf4caeb2d6ca8932a215a353d0703c326ec2d81fc68170f320eb2ab49e9df61f9.
+//
+// Solidity: event AuthorizedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) ParseAuthorizedOperator(log types.Log) (*ERC777TokenAuthorizedOperator, error) {
+	event := new(ERC777TokenAuthorizedOperator)
+	if err := _ERC777Token.contract.UnpackLog(event, "AuthorizedOperator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenBurnedIterator is returned from FilterBurned and is used to iterate over the raw logs and unpacked data for Burned events raised by the ERC777Token contract.
+type ERC777TokenBurnedIterator struct {
+	Event *ERC777TokenBurned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenBurnedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenBurned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenBurned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenBurnedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenBurnedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenBurned represents a Burned event raised by the ERC777Token contract.
+type ERC777TokenBurned struct {
+	Operator     common.Address
+	From         common.Address
+	Amount       *big.Int
+	Data         []byte
+	OperatorData []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterBurned is a free log retrieval operation binding the contract event 0xa78a9be3a7b862d26933ad85fb11d80ef66b8f972d7cbba06621d583943a4098.
+//
+// Solidity: event Burned(address indexed operator, address indexed from, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) FilterBurned(opts *bind.FilterOpts, operator []common.Address, from []common.Address) (*ERC777TokenBurnedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "Burned", operatorRule, fromRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenBurnedIterator{contract: _ERC777Token.contract, event: "Burned", logs: logs, sub: sub}, nil
+}
+
+// WatchBurned is a free log subscription operation binding the contract event 0xa78a9be3a7b862d26933ad85fb11d80ef66b8f972d7cbba06621d583943a4098.
+//
+// Solidity: event Burned(address indexed operator, address indexed from, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) WatchBurned(opts *bind.WatchOpts, sink chan<- *ERC777TokenBurned, operator []common.Address, from []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "Burned", operatorRule, fromRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenBurned)
+				if err := _ERC777Token.contract.UnpackLog(event, "Burned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBurned is a log parse operation binding the contract event 0xa78a9be3a7b862d26933ad85fb11d80ef66b8f972d7cbba06621d583943a4098.
+//
+// Solidity: event Burned(address indexed operator, address indexed from, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) ParseBurned(log types.Log) (*ERC777TokenBurned, error) {
+	event := new(ERC777TokenBurned)
+	if err := _ERC777Token.contract.UnpackLog(event, "Burned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenMintedIterator is returned from FilterMinted and is used to iterate over the raw logs and unpacked data for Minted events raised by the ERC777Token contract.
+type ERC777TokenMintedIterator struct {
+	Event *ERC777TokenMinted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenMintedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenMinted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenMinted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenMintedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenMintedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenMinted represents a Minted event raised by the ERC777Token contract.
+type ERC777TokenMinted struct {
+	Operator     common.Address
+	To           common.Address
+	Amount       *big.Int
+	Data         []byte
+	OperatorData []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinted is a free log retrieval operation binding the contract event 0x2fe5be0146f74c5bce36c0b80911af6c7d86ff27e89d5cfa61fc681327954e5d.
+//
+// Solidity: event Minted(address indexed operator, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) FilterMinted(opts *bind.FilterOpts, operator []common.Address, to []common.Address) (*ERC777TokenMintedIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "Minted", operatorRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenMintedIterator{contract: _ERC777Token.contract, event: "Minted", logs: logs, sub: sub}, nil
+}
+
+// WatchMinted is a free log subscription operation binding the contract event 0x2fe5be0146f74c5bce36c0b80911af6c7d86ff27e89d5cfa61fc681327954e5d.
+//
+// Solidity: event Minted(address indexed operator, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) WatchMinted(opts *bind.WatchOpts, sink chan<- *ERC777TokenMinted, operator []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "Minted", operatorRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenMinted)
+				if err := _ERC777Token.contract.UnpackLog(event, "Minted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinted is a log parse operation binding the contract event 0x2fe5be0146f74c5bce36c0b80911af6c7d86ff27e89d5cfa61fc681327954e5d.
+//
+// Solidity: event Minted(address indexed operator, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) ParseMinted(log types.Log) (*ERC777TokenMinted, error) {
+	event := new(ERC777TokenMinted)
+	if err := _ERC777Token.contract.UnpackLog(event, "Minted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenRevokedOperatorIterator is returned from FilterRevokedOperator and is used to iterate over the raw logs and unpacked data for RevokedOperator events raised by the ERC777Token contract.
+type ERC777TokenRevokedOperatorIterator struct {
+	Event *ERC777TokenRevokedOperator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenRevokedOperatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenRevokedOperator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenRevokedOperator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenRevokedOperatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenRevokedOperatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenRevokedOperator represents a RevokedOperator event raised by the ERC777Token contract.
+type ERC777TokenRevokedOperator struct {
+	Operator    common.Address
+	TokenHolder common.Address
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterRevokedOperator is a free log retrieval operation binding the contract event 0x50546e66e5f44d728365dc3908c63bc5cfeeab470722c1677e3073a6ac294aa1.
+//
+// Solidity: event RevokedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) FilterRevokedOperator(opts *bind.FilterOpts, operator []common.Address, tokenHolder []common.Address) (*ERC777TokenRevokedOperatorIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var tokenHolderRule []interface{}
+	for _, tokenHolderItem := range tokenHolder {
+		tokenHolderRule = append(tokenHolderRule, tokenHolderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "RevokedOperator", operatorRule, tokenHolderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenRevokedOperatorIterator{contract: _ERC777Token.contract, event: "RevokedOperator", logs: logs, sub: sub}, nil
+}
+
+// WatchRevokedOperator is a free log subscription operation binding the contract event 0x50546e66e5f44d728365dc3908c63bc5cfeeab470722c1677e3073a6ac294aa1.
+//
+// Solidity: event RevokedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) WatchRevokedOperator(opts *bind.WatchOpts, sink chan<- *ERC777TokenRevokedOperator, operator []common.Address, tokenHolder []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var tokenHolderRule []interface{}
+	for _, tokenHolderItem := range tokenHolder {
+		tokenHolderRule = append(tokenHolderRule, tokenHolderItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "RevokedOperator", operatorRule, tokenHolderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenRevokedOperator)
+				if err := _ERC777Token.contract.UnpackLog(event, "RevokedOperator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRevokedOperator is a log parse operation binding the contract event 0x50546e66e5f44d728365dc3908c63bc5cfeeab470722c1677e3073a6ac294aa1.
+//
+// Solidity: event RevokedOperator(address indexed operator, address indexed tokenHolder)
+func (_ERC777Token *ERC777TokenFilterer) ParseRevokedOperator(log types.Log) (*ERC777TokenRevokedOperator, error) {
+	event := new(ERC777TokenRevokedOperator)
+	if err := _ERC777Token.contract.UnpackLog(event, "RevokedOperator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenSentIterator is returned from FilterSent and is used to iterate over the raw logs and unpacked data for Sent events raised by the ERC777Token contract.
+type ERC777TokenSentIterator struct {
+	Event *ERC777TokenSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenSent represents a Sent event raised by the ERC777Token contract.
+type ERC777TokenSent struct {
+	Operator     common.Address
+	From         common.Address
+	To           common.Address
+	Amount       *big.Int
+	Data         []byte
+	OperatorData []byte
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterSent is a free log retrieval operation binding the contract event 0x06b541ddaa720db2b10a4d0cdac39b8d360425fc073085fac19bc82614677987.
+//
+// Solidity: event Sent(address indexed operator, address indexed from, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) FilterSent(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*ERC777TokenSentIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "Sent", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenSentIterator{contract: _ERC777Token.contract, event: "Sent", logs: logs, sub: sub}, nil
+}
+
+// WatchSent is a free log subscription operation binding the contract event 0x06b541ddaa720db2b10a4d0cdac39b8d360425fc073085fac19bc82614677987.
+//
+// Solidity: event Sent(address indexed operator, address indexed from, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) WatchSent(opts *bind.WatchOpts, sink chan<- *ERC777TokenSent, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "Sent", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenSent)
+				if err := _ERC777Token.contract.UnpackLog(event, "Sent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSent is a log parse operation binding the contract event 0x06b541ddaa720db2b10a4d0cdac39b8d360425fc073085fac19bc82614677987.
+//
+// Solidity: event Sent(address indexed operator, address indexed from, address indexed to, uint256 amount, bytes data, bytes operatorData)
+func (_ERC777Token *ERC777TokenFilterer) ParseSent(log types.Log) (*ERC777TokenSent, error) {
+	event := new(ERC777TokenSent)
+	if err := _ERC777Token.contract.UnpackLog(event, "Sent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC777TokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC777Token contract.
+type ERC777TokenTransferIterator struct {
+	Event *ERC777TokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC777TokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC777TokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC777TokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC777TokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC777TokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC777TokenTransfer represents a Transfer event raised by the ERC777Token contract.
+type ERC777TokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*ERC777TokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC777TokenTransferIterator{contract: _ERC777Token.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC777TokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _ERC777Token.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC777TokenTransfer)
+				if err := _ERC777Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_ERC777Token *ERC777TokenFilterer) ParseTransfer(log types.Log) (*ERC777TokenTransfer, error) {
+	event := new(ERC777TokenTransfer)
+	if err := _ERC777Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1325 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package main
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RestrictedERC20TokenMetaData contains all meta data concerning the RestrictedERC20Token contract.
+var RestrictedERC20TokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"initialSupply\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"BlockedAddress\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"allowance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientAllowance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"needed\",\"type\":\"uint256\"}],\"name\":\"ERC20InsufficientBalance\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidApprover\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"}],\"name\":\"ERC20InvalidReceiver\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"ERC20InvalidSpender\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"AddressBlocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"AddressUnblocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"blockAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"isBlocked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"unblockAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// RestrictedERC20TokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use RestrictedERC20TokenMetaData.ABI instead.
+var RestrictedERC20TokenABI = RestrictedERC20TokenMetaData.ABI
+
+// RestrictedERC20Token is an auto generated Go binding around an Ethereum contract.
+type RestrictedERC20Token struct {
+	RestrictedERC20TokenCaller     // Read-only binding to the contract
+	RestrictedERC20TokenTransactor // Write-only binding to the contract
+	RestrictedERC20TokenFilterer   // Log filterer for contract events
+}
+
+// RestrictedERC20TokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RestrictedERC20TokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RestrictedERC20TokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RestrictedERC20TokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RestrictedERC20TokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RestrictedERC20TokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RestrictedERC20TokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RestrictedERC20TokenSession struct {
+	Contract     *RestrictedERC20Token // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// RestrictedERC20TokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RestrictedERC20TokenCallerSession struct {
+	Contract *RestrictedERC20TokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// RestrictedERC20TokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RestrictedERC20TokenTransactorSession struct {
+	Contract     *RestrictedERC20TokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// RestrictedERC20TokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RestrictedERC20TokenRaw struct {
+	Contract *RestrictedERC20Token // Generic contract binding to access the raw methods on
+}
+
+// RestrictedERC20TokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RestrictedERC20TokenCallerRaw struct {
+	Contract *RestrictedERC20TokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RestrictedERC20TokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RestrictedERC20TokenTransactorRaw struct {
+	Contract *RestrictedERC20TokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRestrictedERC20Token creates a new instance of RestrictedERC20Token, bound to a specific deployed contract.
+func NewRestrictedERC20Token(address common.Address, backend bind.ContractBackend) (*RestrictedERC20Token, error) {
+	contract, err := bindRestrictedERC20Token(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20Token{RestrictedERC20TokenCaller: RestrictedERC20TokenCaller{contract: contract}, RestrictedERC20TokenTransactor: RestrictedERC20TokenTransactor{contract: contract}, RestrictedERC20TokenFilterer: RestrictedERC20TokenFilterer{contract: contract}}, nil
+}
+
+// NewRestrictedERC20TokenCaller creates a new read-only instance of RestrictedERC20Token, bound to a specific deployed contract.
+func NewRestrictedERC20TokenCaller(address common.Address, caller bind.ContractCaller) (*RestrictedERC20TokenCaller, error) {
+	contract, err := bindRestrictedERC20Token(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenCaller{contract: contract}, nil
+}
+
+// NewRestrictedERC20TokenTransactor creates a new write-only instance of RestrictedERC20Token, bound to a specific deployed contract.
+func NewRestrictedERC20TokenTransactor(address common.Address, transactor bind.ContractTransactor) (*RestrictedERC20TokenTransactor, error) {
+	contract, err := bindRestrictedERC20Token(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenTransactor{contract: contract}, nil
+}
+
+// NewRestrictedERC20TokenFilterer creates a new log filterer instance of RestrictedERC20Token, bound to a specific deployed contract.
+func NewRestrictedERC20TokenFilterer(address common.Address, filterer bind.ContractFilterer) (*RestrictedERC20TokenFilterer, error) {
+	contract, err := bindRestrictedERC20Token(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenFilterer{contract: contract}, nil
+}
+
+// bindRestrictedERC20Token binds a generic wrapper to an already deployed contract.
+func bindRestrictedERC20Token(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RestrictedERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RestrictedERC20Token *RestrictedERC20TokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RestrictedERC20Token.Contract.RestrictedERC20TokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RestrictedERC20Token *RestrictedERC20TokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.RestrictedERC20TokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RestrictedERC20Token *RestrictedERC20TokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.RestrictedERC20TokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RestrictedERC20Token.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.contract.Transact(opts, method, params...)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "allowance", owner, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.Allowance(&_RestrictedERC20Token.CallOpts, owner, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner, address spender) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) Allowance(owner common.Address, spender common.Address) (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.Allowance(&_RestrictedERC20Token.CallOpts, owner, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.BalanceOf(&_RestrictedERC20Token.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.BalanceOf(&_RestrictedERC20Token.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Decimals() (uint8, error) {
+	return _RestrictedERC20Token.Contract.Decimals(&_RestrictedERC20Token.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) Decimals() (uint8, error) {
+	return _RestrictedERC20Token.Contract.Decimals(&_RestrictedERC20Token.CallOpts)
+}
+
+// IsBlocked is a free data retrieval call binding the contract method 0xfbac3951.
+//
+// Solidity: function isBlocked(address account) view returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) IsBlocked(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "isBlocked", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsBlocked is a free data retrieval call binding the contract method 0xfbac3951.
+//
+// Solidity: function isBlocked(address account) view returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) IsBlocked(account common.Address) (bool, error) {
+	return _RestrictedERC20Token.Contract.IsBlocked(&_RestrictedERC20Token.CallOpts, account)
+}
+
+// IsBlocked is a free data retrieval call binding the contract method 0xfbac3951.
+//
+// Solidity: function isBlocked(address account) view returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) IsBlocked(account common.Address) (bool, error) {
+	return _RestrictedERC20Token.Contract.IsBlocked(&_RestrictedERC20Token.CallOpts, account)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Name() (string, error) {
+	return _RestrictedERC20Token.Contract.Name(&_RestrictedERC20Token.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) Name() (string, error) {
+	return _RestrictedERC20Token.Contract.Name(&_RestrictedERC20Token.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Owner() (common.Address, error) {
+	return _RestrictedERC20Token.Contract.Owner(&_RestrictedERC20Token.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) Owner() (common.Address, error) {
+	return _RestrictedERC20Token.Contract.Owner(&_RestrictedERC20Token.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Symbol() (string, error) {
+	return _RestrictedERC20Token.Contract.Symbol(&_RestrictedERC20Token.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) Symbol() (string, error) {
+	return _RestrictedERC20Token.Contract.Symbol(&_RestrictedERC20Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RestrictedERC20Token.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) TotalSupply() (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.TotalSupply(&_RestrictedERC20Token.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_RestrictedERC20Token *RestrictedERC20TokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _RestrictedERC20Token.Contract.TotalSupply(&_RestrictedERC20Token.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "approve", spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.Approve(&_RestrictedERC20Token.TransactOpts, spender, value)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) Approve(spender common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.Approve(&_RestrictedERC20Token.TransactOpts, spender, value)
+}
+
+// BlockAddress is a paid mutator transaction binding the contract method 0xad2bb1b3.
+//
+// Solidity: function blockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) BlockAddress(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "blockAddress", account)
+}
+
+// BlockAddress is a paid mutator transaction binding the contract method 0xad2bb1b3.
+//
+// Solidity: function blockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) BlockAddress(account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.BlockAddress(&_RestrictedERC20Token.TransactOpts, account)
+}
+
+// BlockAddress is a paid mutator transaction binding the contract method 0xad2bb1b3.
+//
+// Solidity: function blockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) BlockAddress(account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.BlockAddress(&_RestrictedERC20Token.TransactOpts, account)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) RenounceOwnership() (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.RenounceOwnership(&_RestrictedERC20Token.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.RenounceOwnership(&_RestrictedERC20Token.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) Transfer(opts *bind.TransactOpts, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "transfer", to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.Transfer(&_RestrictedERC20Token.TransactOpts, to, value)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) Transfer(to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.Transfer(&_RestrictedERC20Token.TransactOpts, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "transferFrom", from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.TransferFrom(&_RestrictedERC20Token.TransactOpts, from, to, value)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 value) returns(bool)
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) TransferFrom(from common.Address, to common.Address, value *big.Int) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.TransferFrom(&_RestrictedERC20Token.TransactOpts, from, to, value)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.TransferOwnership(&_RestrictedERC20Token.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.TransferOwnership(&_RestrictedERC20Token.TransactOpts, newOwner)
+}
+
+// UnblockAddress is a paid mutator transaction binding the contract method 0x186d9d88.
+//
+// Solidity: function unblockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactor) UnblockAddress(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.contract.Transact(opts, "unblockAddress", account)
+}
+
+// UnblockAddress is a paid mutator transaction binding the contract method 0x186d9d88.
+//
+// Solidity: function unblockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenSession) UnblockAddress(account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.UnblockAddress(&_RestrictedERC20Token.TransactOpts, account)
+}
+
+// UnblockAddress is a paid mutator transaction binding the contract method 0x186d9d88.
+//
+// Solidity: function unblockAddress(address account) returns()
+func (_RestrictedERC20Token *RestrictedERC20TokenTransactorSession) UnblockAddress(account common.Address) (*types.Transaction, error) {
+	return _RestrictedERC20Token.Contract.UnblockAddress(&_RestrictedERC20Token.TransactOpts, account)
+}
+
+// RestrictedERC20TokenAddressBlockedIterator is returned from FilterAddressBlocked and is used to iterate over the raw logs and unpacked data for AddressBlocked events raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenAddressBlockedIterator struct {
+	Event *RestrictedERC20TokenAddressBlocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RestrictedERC20TokenAddressBlockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RestrictedERC20TokenAddressBlocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RestrictedERC20TokenAddressBlocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RestrictedERC20TokenAddressBlockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RestrictedERC20TokenAddressBlockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RestrictedERC20TokenAddressBlocked represents a AddressBlocked event raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenAddressBlocked struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddressBlocked is a free log retrieval operation binding the contract event 0x71fa9c99da9552de60a9ccf693f5a91456cbb8c205c8e532b1f55339903543cf.
+//
+// Solidity: event AddressBlocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) FilterAddressBlocked(opts *bind.FilterOpts, account []common.Address) (*RestrictedERC20TokenAddressBlockedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.FilterLogs(opts, "AddressBlocked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenAddressBlockedIterator{contract: _RestrictedERC20Token.contract, event: "AddressBlocked", logs: logs, sub: sub}, nil
+}
+
+// WatchAddressBlocked is a free log subscription operation binding the contract event 0x71fa9c99da9552de60a9ccf693f5a91456cbb8c205c8e532b1f55339903543cf.
+//
+// Solidity: event AddressBlocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) WatchAddressBlocked(opts *bind.WatchOpts, sink chan<- *RestrictedERC20TokenAddressBlocked, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.WatchLogs(opts, "AddressBlocked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RestrictedERC20TokenAddressBlocked)
+				if err := _RestrictedERC20Token.contract.UnpackLog(event, "AddressBlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddressBlocked is a log parse operation binding the contract event 0x71fa9c99da9552de60a9ccf693f5a91456cbb8c205c8e532b1f55339903543cf.
+//
+// Solidity: event AddressBlocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) ParseAddressBlocked(log types.Log) (*RestrictedERC20TokenAddressBlocked, error) {
+	event := new(RestrictedERC20TokenAddressBlocked)
+	if err := _RestrictedERC20Token.contract.UnpackLog(event, "AddressBlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RestrictedERC20TokenAddressUnblockedIterator is returned from FilterAddressUnblocked and is used to iterate over the raw logs and unpacked data for AddressUnblocked events raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenAddressUnblockedIterator struct {
+	Event *RestrictedERC20TokenAddressUnblocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RestrictedERC20TokenAddressUnblockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RestrictedERC20TokenAddressUnblocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RestrictedERC20TokenAddressUnblocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RestrictedERC20TokenAddressUnblockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RestrictedERC20TokenAddressUnblockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RestrictedERC20TokenAddressUnblocked represents a AddressUnblocked event raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenAddressUnblocked struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddressUnblocked is a free log retrieval operation binding the contract event 0x385b21b54e2348e690dec29e9741026142b02eaabfcc2fcb676cebcb7cd16534.
+//
+// Solidity: event AddressUnblocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) FilterAddressUnblocked(opts *bind.FilterOpts, account []common.Address) (*RestrictedERC20TokenAddressUnblockedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.FilterLogs(opts, "AddressUnblocked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenAddressUnblockedIterator{contract: _RestrictedERC20Token.contract, event: "AddressUnblocked", logs: logs, sub: sub}, nil
+}
+
+// WatchAddressUnblocked is a free log subscription operation binding the contract event 0x385b21b54e2348e690dec29e9741026142b02eaabfcc2fcb676cebcb7cd16534.
+//
+// Solidity: event AddressUnblocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) WatchAddressUnblocked(opts *bind.WatchOpts, sink chan<- *RestrictedERC20TokenAddressUnblocked, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.WatchLogs(opts, "AddressUnblocked", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RestrictedERC20TokenAddressUnblocked)
+				if err := _RestrictedERC20Token.contract.UnpackLog(event, "AddressUnblocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddressUnblocked is a log parse operation binding the contract event 0x385b21b54e2348e690dec29e9741026142b02eaabfcc2fcb676cebcb7cd16534.
+//
+// Solidity: event AddressUnblocked(address indexed account)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) ParseAddressUnblocked(log types.Log) (*RestrictedERC20TokenAddressUnblocked, error) {
+	event := new(RestrictedERC20TokenAddressUnblocked)
+	if err := _RestrictedERC20Token.contract.UnpackLog(event, "AddressUnblocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RestrictedERC20TokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenApprovalIterator struct {
+	Event *RestrictedERC20TokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RestrictedERC20TokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RestrictedERC20TokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RestrictedERC20TokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RestrictedERC20TokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RestrictedERC20TokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RestrictedERC20TokenApproval represents a Approval event raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*RestrictedERC20TokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenApprovalIterator{contract: _RestrictedERC20Token.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *RestrictedERC20TokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RestrictedERC20TokenApproval)
+				if err := _RestrictedERC20Token.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) ParseApproval(log types.Log) (*RestrictedERC20TokenApproval, error) {
+	event := new(RestrictedERC20TokenApproval)
+	if err := _RestrictedERC20Token.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RestrictedERC20TokenOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenOwnershipTransferredIterator struct {
+	Event *RestrictedERC20TokenOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RestrictedERC20TokenOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RestrictedERC20TokenOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RestrictedERC20TokenOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RestrictedERC20TokenOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RestrictedERC20TokenOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RestrictedERC20TokenOwnershipTransferred represents a OwnershipTransferred event raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*RestrictedERC20TokenOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenOwnershipTransferredIterator{contract: _RestrictedERC20Token.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *RestrictedERC20TokenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RestrictedERC20TokenOwnershipTransferred)
+				if err := _RestrictedERC20Token.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) ParseOwnershipTransferred(log types.Log) (*RestrictedERC20TokenOwnershipTransferred, error) {
+	event := new(RestrictedERC20TokenOwnershipTransferred)
+	if err := _RestrictedERC20Token.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// RestrictedERC20TokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenTransferIterator struct {
+	Event *RestrictedERC20TokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RestrictedERC20TokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RestrictedERC20TokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RestrictedERC20TokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RestrictedERC20TokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RestrictedERC20TokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RestrictedERC20TokenTransfer represents a Transfer event raised by the RestrictedERC20Token contract.
+type RestrictedERC20TokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*RestrictedERC20TokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RestrictedERC20TokenTransferIterator{contract: _RestrictedERC20Token.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *RestrictedERC20TokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _RestrictedERC20Token.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RestrictedERC20TokenTransfer)
+				if err := _RestrictedERC20Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_RestrictedERC20Token *RestrictedERC20TokenFilterer) ParseTransfer(log types.Log) (*RestrictedERC20TokenTransfer, error) {
+	event := new(RestrictedERC20TokenTransfer)
+	if err := _RestrictedERC20Token.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// accessList is the EIP-2930 access list of -access-list, attached to
+	// every transaction sent.
+	accessList     types.AccessList
+	autoAccessList bool
+)
+
+// accessListFlag sets accessList from inline JSON or a JSON file.
+type accessListFlag struct{}
+
+func (accessListFlag) String() string {
+	return ""
+}
+
+func (accessListFlag) Set(value string) error {
+	list, err := parseAccessList(value)
+	if err != nil {
+		return err
+	}
+	accessList = list
+	return nil
+}
+
+// parseAccessList reads an access list such as
+//
+//	[{"address": "0x...", "storageKeys": ["0x...", ...]}]
+//
+// given inline or as the path of a file holding it.
+func parseAccessList(value string) (types.AccessList, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "[") {
+		var err error
+		if data, err = os.ReadFile(value); err != nil {
+			return nil, fmt.Errorf("failed to read access list: %w", err)
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var entries []struct {
+		Address     string   `json:"address"`
+		StorageKeys []string `json:"storageKeys"`
+	}
+	if err := decoder.Decode(&entries); err != nil {
+		return nil, fmt.Errorf("invalid access list, expected [{\"address\": ..., \"storageKeys\": [...]}]: %w", err)
+	}
+
+	list := make(types.AccessList, 0, len(entries))
+	for i, entry := range entries {
+		if !common.IsHexAddress(entry.Address) {
+			return nil, fmt.Errorf("invalid access list: entry %d has an invalid address %q", i+1, entry.Address)
+		}
+		tuple := types.AccessTuple{Address: common.HexToAddress(entry.Address), StorageKeys: []common.Hash{}}
+		for _, key := range entry.StorageKeys {
+			b, err := hexutil.Decode(key)
+			if err != nil || len(b) != common.HashLength {
+				return nil, fmt.Errorf("invalid access list: storage key %q of entry %d is not 32 bytes of 0x-prefixed hex", key, i+1)
+			}
+			tuple.StorageKeys = append(tuple.StorageKeys, common.BytesToHash(b))
+		}
+		list = append(list, tuple)
+	}
+	return list, nil
+}
+
+// withAccessList wraps sign so that the transactions it signs carry the
+// -access-list, or with -auto-access-list the one eth_createAccessList
+// derives for each of them. A legacy transaction becomes an EIP-2930 one
+// for that, a dynamic fee one keeps its type.
+func withAccessList(ctx context.Context, client *ethclient.Client, sign bind.SignerFn, chainID *big.Int) bind.SignerFn {
+	return func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		list := accessList
+		if autoAccessList {
+			var err error
+			if list, err = createAccessList(ctx, client, from, tx); err != nil {
+				slog.Warn("Failed to create an access list, sending without one", "err", err)
+			}
+		}
+		if len(list) == 0 {
+			return sign(from, tx)
+		}
+		return sign(from, addAccessList(tx, list, chainID))
+	}
+}
+
+// addAccessList returns tx with list attached. The gas limit grows by the
+// intrinsic cost of the list, the accesses it prepays only get cheaper.
+func addAccessList(tx *types.Transaction, list types.AccessList, chainID *big.Int) *types.Transaction {
+	gas := tx.Gas() + uint64(len(list))*params.TxAccessListAddressGas + uint64(list.StorageKeys())*params.TxAccessListStorageKeyGas
+	if tx.Type() == types.DynamicFeeTxType {
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        gas,
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: list,
+		})
+	}
+	return types.NewTx(&types.AccessListTx{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce(),
+		GasPrice:   tx.GasPrice(),
+		Gas:        gas,
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: list,
+	})
+}
+
+// createAccessList asks the node for the access list of tx and returns it if
+// it lowers the gas the transaction uses, logging the savings; otherwise it
+// returns nil.
+func createAccessList(ctx context.Context, client *ethclient.Client, from common.Address, tx *types.Transaction) (types.AccessList, error) {
+	msg := ethereum.CallMsg{From: from, To: tx.To(), Value: tx.Value(), Data: tx.Data()}
+	var gasWith uint64
+	list, err := withRetry(ctx, "create access list", func() (*types.AccessList, error) {
+		list, gasUsed, vmErr, err := gethclient.New(client.Client()).CreateAccessList(ctx, msg)
+		if err == nil && vmErr != "" {
+			// A failing transaction has nothing to save, and is reported
+			// when it is sent.
+			return nil, fmt.Errorf("the transaction fails: %s", vmErr)
+		}
+		gasWith = gasUsed
+		return list, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	gasWithout, err := withRetry(ctx, "estimate gas", func() (uint64, error) {
+		return client.EstimateGas(ctx, msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if list == nil || len(*list) == 0 || gasWith >= gasWithout {
+		slog.Info("An access list would not save gas, sending without one", "gasWith", gasWith, "gasWithout", gasWithout)
+		return nil, nil
+	}
+	slog.Info("Attaching the access list from eth_createAccessList", "addresses", len(*list), "storageKeys", list.StorageKeys(),
+		"gasWith", gasWith, "gasWithout", gasWithout, "saved", gasWithout-gasWith)
+	return *list, nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// strictAddresses makes parseAddress reject addresses with a wrong EIP-55
+// checksum instead of warning about them.
+var strictAddresses bool
+
+// parseAddress parses a hex address given by the user. A mixed-case address
+// carries an EIP-55 checksum; a wrong one usually means a typo, so it is
+// warned about, or rejected with -strict. All-lowercase and all-uppercase
+// addresses have no checksum to check.
+func parseAddress(s string) (common.Address, error) {
+	s = strings.TrimSpace(s)
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	switch {
+	case s == "":
+		return common.Address{}, fmt.Errorf("empty address")
+	case len(digits) != 2*common.AddressLength:
+		return common.Address{}, fmt.Errorf("%q has %d hex digits, an address has %d", s, len(digits), 2*common.AddressLength)
+	case !common.IsHexAddress(s):
+		return common.Address{}, fmt.Errorf("%q is not hexadecimal", s)
+	}
+
+	address := common.HexToAddress(s)
+	if digits != strings.ToLower(digits) && digits != strings.ToUpper(digits) && "0x"+digits != address.Hex() {
+		if strictAddresses {
+			return common.Address{}, fmt.Errorf("%s has an invalid EIP-55 checksum, check it for typos (the checksummed form is %s)", s, address.Hex())
+		}
+		slog.Warn("Address has an invalid EIP-55 checksum, check it for typos", "address", s, "checksummed", address.Hex())
+	}
+	return address, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+var (
+	// errRateLimited is returned when requests arrive faster than -rate.
+	errRateLimited = errors.New("too many requests, slow down")
+	// errQueueFull is returned when -max-concurrent deployments are running
+	// and -max-queue more are waiting.
+	errQueueFull = errors.New("too many deployments in progress, try again later")
+)
+
+// admission limits the deploy requests serve works on. Requests beyond the
+// rate are refused, at most maxRunning deployments run at once and at most
+// maxQueued wait for their turn, further ones are refused.
+type admission struct {
+	limiter    *rate.Limiter // nil without a rate limit
+	running    chan struct{}
+	maxPending int
+
+	mu      sync.Mutex
+	pending int // running and queued
+}
+
+// newAdmission returns an admission for up to maxRunning concurrent and
+// maxQueued waiting deployments. A perSecond of zero turns off the rate
+// limit.
+func newAdmission(maxRunning, maxQueued int, perSecond float64) *admission {
+	a := &admission{
+		running:    make(chan struct{}, maxRunning),
+		maxPending: maxRunning + maxQueued,
+	}
+	if perSecond > 0 {
+		a.limiter = rate.NewLimiter(rate.Limit(perSecond), max(1, int(math.Ceil(perSecond))))
+	}
+	return a
+}
+
+// acquire waits until the request may run and returns the function that
+// ends its turn. It fails right away with errRateLimited or errQueueFull
+// when the request is refused.
+func (a *admission) acquire(ctx context.Context) (release func(), err error) {
+	if a.limiter != nil && !a.limiter.Allow() {
+		return nil, errRateLimited
+	}
+	a.mu.Lock()
+	if a.pending >= a.maxPending {
+		a.mu.Unlock()
+		return nil, errQueueFull
+	}
+	a.pending++
+	a.mu.Unlock()
+
+	done := func() {
+		a.mu.Lock()
+		a.pending--
+		a.mu.Unlock()
+	}
+	select {
+	case a.running <- struct{}{}:
+	case <-ctx.Done():
+		done()
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-a.running
+		done()
+	}, nil
+}
@@ -0,0 +1,414 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// disperseAddress is where Disperse (https://disperse.app) is deployed on
+// mainnet and most public chains. Its disperseToken pulls the tokens from the
+// caller with transferFrom, so it needs an allowance first.
+var disperseAddress = common.HexToAddress("0xD152f549545093347A162Dce210e7293f1452150")
+
+const disperseABI = `[{"inputs":[{"name":"token","type":"address"},{"name":"recipients","type":"address[]"},{"name":"values","type":"uint256[]"}],"name":"disperseToken","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// airdropRow is one recipient of an airdrop CSV file.
+type airdropRow struct {
+	line   int
+	to     common.Address
+	amount string
+	value  *big.Int
+}
+
+func runAirdrop(args []string) {
+	fs := newFlagSet("airdrop")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	csvPath := fs.String("csv", "", "CSV file with address,amount rows, amounts in whole units")
+	multicall := fs.Bool("multicall", false, "Send the transfers in batches through a Disperse contract instead of one by one")
+	disperse := fs.String("disperse", disperseAddress.Hex(), "Address of the Disperse contract used with -multicall")
+	batchSize := fs.Int("batch-size", 100, "Recipients per transaction with -multicall")
+	preflight := fs.Bool("preflight", false, "Simulate every transfer with eth_call first and send nothing if any would fail")
+	progressPath := fs.String("progress", "", "File recording the recipients already sent to, kept until every transfer succeeded (default: -csv with .progress.json appended)")
+	resume := fs.Bool("resume", false, "Continue an interrupted airdrop from its -progress file, skipping the recipients it already sent to")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *csvPath == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -csv")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*disperse); err != nil {
+		fatalf("Invalid Disperse address: %v", err)
+	}
+	if *batchSize < 1 {
+		fatal("-batch-size must be at least 1")
+	}
+	if *progressPath == "" {
+		*progressPath = *csvPath + ".progress.json"
+	}
+
+	f, err := os.Open(*csvPath)
+	if err != nil {
+		fatalf("Failed to open CSV file: %v", err)
+	}
+	rows, err := readAirdropCSV(f)
+	f.Close()
+	if err != nil {
+		fatal(err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	token := common.HexToAddress(*contract)
+	instance, err := NewERC20Token(token, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := tokenDecimals(ctx, client, token)
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		fatalf("Failed to get chain ID: %v", err)
+	}
+	progress, err := loadAirdropProgress(*progressPath, token, account.address, chainID.Uint64(), *resume)
+	if err != nil {
+		fatal(err)
+	}
+	if err := progress.resolvePending(ctx, client); err != nil {
+		fatal(err)
+	}
+	listed := len(rows)
+	rows = slices.DeleteFunc(rows, func(row airdropRow) bool { return progress.sent(row.to) })
+	if len(rows) < listed {
+		fmt.Printf("Skipping %d recipients already sent to, as recorded in %s\n", listed-len(rows), *progressPath)
+	}
+	if len(rows) == 0 {
+		fmt.Println("Every recipient already received their tokens")
+		removeAirdropProgress(*progressPath)
+		return
+	}
+
+	total, err := parseAirdropAmounts(rows, decimals)
+	if err != nil {
+		fatal(err)
+	}
+	balance, err := instance.BalanceOf(&bind.CallOpts{Context: ctx}, account.address)
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	fmt.Printf("Recipients: %d\n", len(rows))
+	fmt.Printf("Total: %s\n", formatAmount(total, decimals))
+	if balance.Cmp(total) < 0 {
+		fatalfCode(exitFailure, "Insufficient token balance: %s holds %s but the airdrop needs %s", account.address.Hex(), formatAmount(balance, decimals), formatAmount(total, decimals))
+	}
+
+	if *preflight {
+		if err := preflightAirdrop(ctx, client, token, account.address, rows); err != nil {
+			fatal(err)
+		}
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, fmt.Sprintf("airdrop tokens to %d recipients", len(rows))); err != nil {
+		fatal(err)
+	}
+
+	// The nonce is tracked locally from here on, and fetched again only if
+	// the node rejects it.
+	nonces := newNonceManager(client, auth)
+	var failed []error
+	if *multicall {
+		failed = airdropBatched(ctx, client, auth, nonces, progress, instance, token, common.HexToAddress(*disperse), rows, total, *batchSize)
+	} else {
+		failed = airdropSequential(ctx, client, auth, nonces, progress, instance, rows)
+	}
+
+	fmt.Printf("\nAirdrop finished: %d succeeded, %d failed\n", len(rows)-len(failed), len(failed))
+	for _, err := range failed {
+		fmt.Printf("  %v\n", err)
+	}
+	if len(failed) > 0 {
+		fmt.Printf("Run the same command with -resume to retry the failed recipients only\n")
+		exit(1)
+	}
+	removeAirdropProgress(*progressPath)
+}
+
+// removeAirdropProgress removes the progress file of a finished airdrop.
+func removeAirdropProgress(path string) {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Warn("Failed to remove the airdrop progress file", "path", path, "err", err)
+	}
+}
+
+// readAirdropCSV reads address,amount rows. A first row whose address column
+// is not an address is taken as a header, and lines starting with # are
+// skipped. Every row is checked before any error is returned so that all
+// problems in a file show up at once.
+func readAirdropCSV(r io.Reader) ([]airdropRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var rows []airdropRow
+	var problems []string
+	seen := make(map[common.Address]int)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount) {
+				problems = append(problems, fmt.Sprintf("line %d: expected address,amount", line))
+				continue
+			}
+			return nil, fmt.Errorf("failed to read CSV file: %w", err)
+		}
+
+		address, amount := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		to, err := parseAddress(address)
+		if err != nil {
+			if first {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("line %d: invalid address: %v", line, err))
+			continue
+		}
+		if to == (common.Address{}) {
+			problems = append(problems, fmt.Sprintf("line %d: the zero address can't receive tokens", line))
+			continue
+		}
+		if first, ok := seen[to]; ok {
+			problems = append(problems, fmt.Sprintf("line %d: %s is already listed on line %d", line, to.Hex(), first))
+			continue
+		}
+		seen[to] = line
+		rows = append(rows, airdropRow{line: line, to: to, amount: amount})
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid CSV file:\n  %s", strings.Join(problems, "\n  "))
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("the CSV file has no recipients")
+	}
+	return rows, nil
+}
+
+// parseAirdropAmounts converts the amounts of rows to base units and returns
+// their sum.
+func parseAirdropAmounts(rows []airdropRow, decimals uint8) (*big.Int, error) {
+	var problems []string
+	total := new(big.Int)
+	for i := range rows {
+		value, err := deployer.ParseUnits(rows[i].amount, int(decimals))
+		if err == nil && value.Sign() <= 0 {
+			err = fmt.Errorf("must be positive")
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: invalid amount %q: %v", rows[i].line, rows[i].amount, err))
+			continue
+		}
+		rows[i].value = value
+		total.Add(total, value)
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid CSV file:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return total, nil
+}
+
+// preflightAirdrop simulates the transfer of every row from the account and
+// lists the recipients whose transfer would fail, e.g. because the token is
+// paused or blocks them. Each transfer is simulated on its own against the
+// current state.
+func preflightAirdrop(ctx context.Context, client *ethclient.Client, token, from common.Address, rows []airdropRow) error {
+	fmt.Printf("Simulating %d transfers...\n", len(rows))
+	var failures []string
+	for _, row := range rows {
+		reason, err := simulateTransfer(ctx, client, token, from, row.to, row.value)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			failures = append(failures, fmt.Sprintf("line %d (%s %s): %s", row.line, row.to.Hex(), row.amount, reason))
+		}
+	}
+	if len(failures) > 0 {
+		return withExitCode(exitReverted, fmt.Errorf("%d of %d transfers would fail, nothing was sent:\n  %s", len(failures), len(rows), strings.Join(failures, "\n  ")))
+	}
+	fmt.Println("All transfers would succeed")
+	return nil
+}
+
+// airdropSequential sends one transfer per row, waiting for each to be mined
+// before sending the next, and returns the failures.
+func airdropSequential(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, nonces *deployer.NonceManager, progress *airdropProgress, instance *ERC20Token, rows []airdropRow) []error {
+	var failed []error
+	for i, row := range rows {
+		prefix := fmt.Sprintf("[%d/%d] %s %s", i+1, len(rows), row.to.Hex(), row.amount)
+		tx, err := sendAirdropTx(ctx, client, auth, nonces, progress, []common.Address{row.to}, "transfer", func() (*types.Transaction, error) {
+			return instance.Transfer(auth, row.to, row.value)
+		})
+		if err != nil {
+			fmt.Printf("%s: FAILED: %v\n", prefix, err)
+			failed = append(failed, fmt.Errorf("line %d (%s): %w", row.line, row.to.Hex(), err))
+			continue
+		}
+		fmt.Printf("%s: ok %s\n", prefix, tx.Hash().Hex())
+	}
+	return failed
+}
+
+// airdropBatched approves the Disperse contract for the total, unless the
+// allowance already covers it, and sends the rows in batches. A failed batch
+// fails all of its rows but doesn't stop the ones after it.
+func airdropBatched(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, nonces *deployer.NonceManager, progress *airdropProgress, instance *ERC20Token, token, disperse common.Address, rows []airdropRow, total *big.Int, batchSize int) []error {
+	code, err := client.CodeAt(ctx, disperse, nil)
+	if err != nil {
+		fatalf("Failed to read Disperse contract code: %v", err)
+	}
+	if len(code) == 0 {
+		fatalf("No Disperse contract at %s on this network, pass -disperse or drop -multicall", disperse.Hex())
+	}
+	parsed, err := abi.JSON(strings.NewReader(disperseABI))
+	if err != nil {
+		fatalf("Failed to parse Disperse ABI: %v", err)
+	}
+	contract := bind.NewBoundContract(disperse, parsed, client, client, client)
+
+	allowance, err := instance.Allowance(&bind.CallOpts{Context: ctx}, auth.From, disperse)
+	if err != nil {
+		fatalf("Failed to query allowance: %v", err)
+	}
+	if allowance.Cmp(total) < 0 {
+		tx, err := sendNext(ctx, client, auth, nonces, "approve the Disperse contract", func() (*types.Transaction, error) {
+			return instance.Approve(auth, disperse, total)
+		})
+		if err != nil {
+			fatal(err)
+		}
+		receipt, err := awaitTransaction(ctx, client, tx, "Approval")
+		if err != nil {
+			fatalf("Approval failed: %v", err)
+		}
+		if receipt.Status != 1 {
+			fatalfCode(exitReverted, "Approval of the Disperse contract reverted, nothing was sent")
+		}
+		fmt.Println()
+	}
+
+	var failed []error
+	for start := 0; start < len(rows); start += batchSize {
+		batch := rows[start:min(start+batchSize, len(rows))]
+		recipients := make([]common.Address, len(batch))
+		values := make([]*big.Int, len(batch))
+		for i, row := range batch {
+			recipients[i], values[i] = row.to, row.value
+		}
+
+		prefix := fmt.Sprintf("[%d-%d/%d]", start+1, start+len(batch), len(rows))
+		tx, err := sendAirdropTx(ctx, client, auth, nonces, progress, recipients, "disperse", func() (*types.Transaction, error) {
+			return contract.Transact(auth, "disperseToken", token, recipients, values)
+		})
+		if err != nil {
+			fmt.Printf("%s FAILED: %v\n", prefix, err)
+			for _, row := range batch {
+				failed = append(failed, fmt.Errorf("line %d (%s): %w", row.line, row.to.Hex(), err))
+			}
+			continue
+		}
+		fmt.Printf("%s ok %s\n", prefix, tx.Hash().Hex())
+		for _, row := range batch {
+			fmt.Printf("  %s %s\n", row.to.Hex(), row.amount)
+		}
+	}
+	return failed
+}
+
+// sendAirdropTx sends the transaction built by build to recipients at the
+// next nonce and waits for it, turning a reverted receipt into an error. The
+// transaction is recorded in progress as pending before it is sent and as
+// confirmed once it succeeded. One that may still be mined, because the wait
+// for it ended early, stays pending for -resume to check.
+func sendAirdropTx(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, nonces *deployer.NonceManager, progress *airdropProgress, recipients []common.Address, action string, build func() (*types.Transaction, error)) (*types.Transaction, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	tx, err := sendNext(ctx, client, auth, nonces, action, func() (*types.Transaction, error) {
+		tx, err := build()
+		if err != nil {
+			return nil, err
+		}
+		if err := progress.setPending(tx, recipients); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	})
+	if err != nil {
+		// A send cut short by an interrupt may still have reached the node.
+		if ctx.Err() == nil {
+			if err := progress.clearPending(recipients); err != nil {
+				fatal(err)
+			}
+		}
+		return nil, err
+	}
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != 1 {
+		if err := progress.clearPending(recipients); err != nil {
+			fatal(err)
+		}
+		return nil, fmt.Errorf("transaction %s reverted: %s", tx.Hash().Hex(), receiptRevertReason(ctx, client, tx, receipt))
+	}
+	if err := progress.confirm(tx.Hash(), recipients); err != nil {
+		fatalf("Transaction %s succeeded but recording it failed, remove its recipients from the CSV file before resuming: %v", tx.Hash().Hex(), err)
+	}
+	return tx, nil
+}
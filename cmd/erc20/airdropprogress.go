@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// airdropProgress records which recipients of an airdrop have received their
+// tokens, so that an interrupted airdrop resumed with -resume sends to the
+// rest only. Transactions are recorded as pending, signed, before they are
+// sent, so a crash between sending and confirming one leaves it behind to
+// check, and to send again if it never reached the node.
+type airdropProgress struct {
+	path string
+
+	Contract  string             `json:"contract"`
+	Sender    string             `json:"sender"`
+	ChainID   uint64             `json:"chainId"`
+	Confirmed map[string]string  `json:"confirmed"` // transaction hash by recipient
+	Pending   []airdropPendingTx `json:"pending,omitempty"`
+}
+
+// airdropPendingTx is a transaction of an airdrop sent, or about to be sent,
+// but not yet seen to succeed or revert.
+type airdropPendingTx struct {
+	Hash       string   `json:"hash"`
+	Nonce      uint64   `json:"nonce"`
+	Recipients []string `json:"recipients"`
+	RawTx      string   `json:"rawTx,omitempty"` // signed transaction, hex encoded
+}
+
+// transaction decodes the signed transaction of e.
+func (e airdropPendingTx) transaction() (*types.Transaction, error) {
+	data, err := hexutil.Decode(e.RawTx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signed transaction %s in the progress file: %w", e.Hash, err)
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return nil, fmt.Errorf("invalid signed transaction %s in the progress file: %w", e.Hash, err)
+	}
+	if tx.Hash().Hex() != e.Hash {
+		return nil, fmt.Errorf("signed transaction in the progress file has hash %s, not %s", tx.Hash().Hex(), e.Hash)
+	}
+	return tx, nil
+}
+
+// loadAirdropProgress returns the progress saved at path for an airdrop of
+// token from sender, or a fresh one if there is none. A progress file left by
+// an earlier run is only picked up with resume, so that running the same
+// airdrop twice by mistake doesn't silently skip or repeat recipients.
+func loadAirdropProgress(path string, token, sender common.Address, chainID uint64, resume bool) (*airdropProgress, error) {
+	fresh := &airdropProgress{path: path, Contract: token.Hex(), Sender: sender.Hex(), ChainID: chainID, Confirmed: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if resume {
+			fmt.Printf("No progress file at %s, starting with the first recipient\n", path)
+		}
+		return fresh, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read airdrop progress: %w", err)
+	}
+	if !resume {
+		return nil, fmt.Errorf("the progress file %s of an earlier airdrop exists, pass -resume to skip the recipients it already sent to or remove it to start over", path)
+	}
+	var saved airdropProgress
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse airdrop progress %s: %w", path, err)
+	}
+	if saved.Contract != fresh.Contract || saved.Sender != fresh.Sender || saved.ChainID != chainID {
+		return nil, fmt.Errorf("the progress file %s is for %s sent by %s on chain %d, remove it to start over", path, saved.Contract, saved.Sender, saved.ChainID)
+	}
+	if saved.Confirmed == nil {
+		saved.Confirmed = map[string]string{}
+	}
+	saved.path = path
+	return &saved, nil
+}
+
+// save writes the progress to its file. The file is replaced in one step so
+// that an interruption while writing leaves the previous progress intact.
+func (p *airdropProgress) save() error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(p.path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("failed to write airdrop progress: %w", err)
+	}
+	if err := os.Rename(p.path+".tmp", p.path); err != nil {
+		return fmt.Errorf("failed to write airdrop progress: %w", err)
+	}
+	return nil
+}
+
+// sent reports whether recipient already received its tokens.
+func (p *airdropProgress) sent(recipient common.Address) bool {
+	_, ok := p.Confirmed[recipient.Hex()]
+	return ok
+}
+
+// setPending records tx, sending to recipients, as pending. It replaces an
+// earlier transaction to the same recipients, which the node rejected before
+// tx was signed again at a fresh nonce.
+func (p *airdropProgress) setPending(tx *types.Transaction, recipients []common.Address) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	first := recipients[0].Hex()
+	p.dropPending(first)
+	entry := airdropPendingTx{Hash: tx.Hash().Hex(), Nonce: tx.Nonce(), RawTx: hexutil.Encode(raw)}
+	for _, r := range recipients {
+		entry.Recipients = append(entry.Recipients, r.Hex())
+	}
+	p.Pending = append(p.Pending, entry)
+	return p.save()
+}
+
+// clearPending forgets the pending transaction to recipients, which reverted
+// or never reached the node, so that they are sent to again on -resume.
+func (p *airdropProgress) clearPending(recipients []common.Address) error {
+	p.dropPending(recipients[0].Hex())
+	return p.save()
+}
+
+// confirm records that the transaction hash delivered the tokens of
+// recipients.
+func (p *airdropProgress) confirm(hash common.Hash, recipients []common.Address) error {
+	p.dropPending(recipients[0].Hex())
+	for _, r := range recipients {
+		p.Confirmed[r.Hex()] = hash.Hex()
+	}
+	return p.save()
+}
+
+// dropPending removes the pending transaction whose first recipient is first.
+func (p *airdropProgress) dropPending(first string) {
+	p.Pending = slices.DeleteFunc(p.Pending, func(e airdropPendingTx) bool {
+		return e.Recipients[0] == first
+	})
+}
+
+// resolvePending checks the outcome of every transaction the interrupted run
+// left pending before anything is sent again. Mined transactions are
+// recorded as confirmed or reverted, ones still in the mempool are waited
+// for. Ones the node doesn't know are sent again while their nonce is unused,
+// since the run may have stopped before sending them, and are only given up
+// on once the nonce was used by another transaction, since until then they
+// could still be mined and the recipients paid twice.
+func (p *airdropProgress) resolvePending(ctx context.Context, client *ethclient.Client) error {
+	for _, entry := range slices.Clone(p.Pending) {
+		hash := common.HexToHash(entry.Hash)
+		recipients := make([]common.Address, len(entry.Recipients))
+		for i, r := range entry.Recipients {
+			recipients[i] = common.HexToAddress(r)
+		}
+
+		receipt, err := client.TransactionReceipt(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			var tx *types.Transaction
+			tx, _, err = client.TransactionByHash(ctx, hash)
+			switch {
+			case errors.Is(err, ethereum.NotFound):
+				var nonce uint64
+				if nonce, err = client.NonceAt(ctx, common.HexToAddress(p.Sender), nil); err != nil {
+					return fmt.Errorf("failed to get nonce: %w", err)
+				}
+				if nonce <= entry.Nonce {
+					// Progress files of older versions hold no signed
+					// transaction to send again.
+					if entry.RawTx == "" {
+						return fmt.Errorf("transaction %s of the last run is unknown to the node but its nonce %d is unused, so it may still be mined: wait until it is mined or the nonce is used, then -resume again", entry.Hash, entry.Nonce)
+					}
+					if tx, err = entry.transaction(); err != nil {
+						return err
+					}
+					fmt.Printf("Transaction %s of the last run is unknown to the node, sending it again\n", entry.Hash)
+					if err := sendTransaction(ctx, client, tx); err != nil {
+						return fmt.Errorf("failed to send transaction %s again: %w", entry.Hash, err)
+					}
+					receipt, err = waitMined(ctx, client, tx)
+					break
+				}
+				// Look again in case it was mined since the first look.
+				receipt, err = client.TransactionReceipt(ctx, hash)
+				if errors.Is(err, ethereum.NotFound) {
+					fmt.Printf("Transaction %s of the last run was never mined, sending to its %d recipients again\n", entry.Hash, len(recipients))
+					if err := p.clearPending(recipients); err != nil {
+						return err
+					}
+					continue
+				}
+			case err != nil:
+				return fmt.Errorf("failed to get transaction %s: %w", entry.Hash, err)
+			default:
+				fmt.Printf("Waiting for transaction %s of the last run to be mined...\n", entry.Hash)
+				receipt, err = waitMined(ctx, client, tx)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get receipt of %s: %w", entry.Hash, err)
+		}
+
+		if receipt.Status != 1 {
+			fmt.Printf("Transaction %s of the last run reverted, sending to its %d recipients again\n", entry.Hash, len(recipients))
+			if err := p.clearPending(recipients); err != nil {
+				return err
+			}
+			continue
+		}
+		fmt.Printf("Transaction %s of the last run succeeded for %d recipients\n", entry.Hash, len(recipients))
+		if err := p.confirm(hash, recipients); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestAirdropProgressPendingRawTx(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	token := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	recipients := []common.Address{common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")}
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(1337)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       60_000,
+		To:        &token,
+		Data:      []byte{0xa9, 0x05, 0x9c, 0xbb},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "airdrop.progress.json")
+	progress, err := loadAirdropProgress(path, token, sender, 1337, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := progress.setPending(tx, recipients); err != nil {
+		t.Fatal(err)
+	}
+
+	// A crash right after setPending leaves only the file behind.
+	resumed, err := loadAirdropProgress(path, token, sender, 1337, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resumed.Pending) != 1 {
+		t.Fatalf("resumed progress has %d pending transactions, want 1", len(resumed.Pending))
+	}
+	entry := resumed.Pending[0]
+	if entry.Nonce != 7 || entry.Hash != tx.Hash().Hex() {
+		t.Errorf("pending entry = nonce %d, hash %s, want nonce 7, hash %s", entry.Nonce, entry.Hash, tx.Hash().Hex())
+	}
+	saved, err := entry.transaction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Hash() != tx.Hash() {
+		t.Errorf("saved transaction has hash %s, want %s", saved.Hash().Hex(), tx.Hash().Hex())
+	}
+
+	entry.Hash = common.Hash{1}.Hex()
+	if _, err := entry.transaction(); err == nil || !strings.Contains(err.Error(), "has hash") {
+		t.Errorf("transaction() with a mismatched hash = %v, want an error", err)
+	}
+	entry.RawTx = "0xzz"
+	if _, err := entry.transaction(); err == nil {
+		t.Error("transaction() with invalid hex succeeded, want an error")
+	}
+
+	if err := resumed.confirm(tx.Hash(), recipients); err != nil {
+		t.Fatal(err)
+	}
+	if len(resumed.Pending) != 0 || !resumed.sent(recipients[0]) {
+		t.Errorf("after confirm: %d pending, sent = %v, want 0 pending and sent", len(resumed.Pending), resumed.sent(recipients[0]))
+	}
+}
+
+func TestLoadAirdropProgressRequiresResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "airdrop.progress.json")
+	token, sender := common.Address{1}, common.Address{2}
+	progress, err := loadAirdropProgress(path, token, sender, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := progress.save(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadAirdropProgress(path, token, sender, 1, false); err == nil {
+		t.Error("loading an existing progress file without resume succeeded, want an error")
+	}
+	if _, err := loadAirdropProgress(path, token, sender, 2, true); err == nil {
+		t.Error("resuming a progress file of another chain succeeded, want an error")
+	}
+}
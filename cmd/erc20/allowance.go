@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func runAllowance(args []string) {
+	fs := newFlagSet("allowance")
+	addRPCFlag(fs)
+	addAccountFlags(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	ownerFlag := fs.String("owner", "", "Address that granted the allowance (defaults to the configured account)")
+	spender := fs.String("spender", "", "Address allowed to spend the tokens")
+	block := addBlockFlag(fs)
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *spender == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -spender")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if *ownerFlag != "" {
+		if _, err := parseAddress(*ownerFlag); err != nil {
+			fatalf("Invalid owner address: %v", err)
+		}
+	}
+	if _, err := parseAddress(*spender); err != nil {
+		fatalf("Invalid spender address: %v", err)
+	}
+
+	var owner common.Address
+	if *ownerFlag != "" {
+		owner = common.HexToAddress(*ownerFlag)
+	} else {
+		account, err := loadSigner()
+		if err != nil {
+			fatalf("Failed to load account for -owner: %v", err)
+		}
+		owner = account.address
+		account.Close()
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	opts, err := block.callOpts(ctx, client)
+	if err != nil {
+		fatal(err)
+	}
+	decimals, err := instance.Decimals(opts)
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	allowance, err := instance.Allowance(opts, owner, common.HexToAddress(*spender))
+	if err != nil {
+		fatalf("Failed to query allowance: %v", err)
+	}
+
+	fmt.Printf("Block: %s\n", describeBlock(opts))
+	fmt.Printf("Owner: %s\n", owner.Hex())
+	fmt.Printf("Spender: %s\n", common.HexToAddress(*spender).Hex())
+	fmt.Printf("Allowance: %s\n", formatAllowance(allowance, decimals))
+	fmt.Printf("Raw: %s\n", allowance)
+}
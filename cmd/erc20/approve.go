@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func runApprove(args []string) {
+	fs := newFlagSet("approve")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	spender := fs.String("spender", "", "Address allowed to spend the tokens")
+	amount := fs.String("amount", "", "Amount of tokens to approve (in whole units), or 'max' for an unlimited allowance")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *spender == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -spender, -amount")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*spender); err != nil {
+		fatalf("Invalid spender address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	decimals, err := tokenDecimals(ctx, client, common.HexToAddress(*contract))
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	var value *big.Int
+	unlimited := strings.EqualFold(*amount, "max")
+	if unlimited {
+		value = new(big.Int).Set(abi.MaxUint256)
+		slog.Warn("APPROVING AN UNLIMITED ALLOWANCE: the spender can move every token this account holds, now or in the future, until the approval is revoked",
+			"spender", common.HexToAddress(*spender).Hex())
+	} else {
+		value, err = parseAmount(*amount, decimals)
+		if err != nil {
+			fatalf("Failed to parse amount: %v", err)
+		}
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "approve "+*amount+" tokens"); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.Approve(auth, common.HexToAddress(*spender), value)
+	if err != nil {
+		fatal(txError("approve", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Approval")
+	if err != nil {
+		fatalf("Approval failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		allowance, err := instance.Allowance(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, auth.From, common.HexToAddress(*spender))
+		if err != nil {
+			fatalf("Failed to query allowance: %v", err)
+		}
+		fmt.Printf("Allowance: %s\n", formatAllowance(allowance, decimals))
+	}
+}
+
+// formatAllowance formats an allowance in whole units, spelling out the
+// conventional unlimited value.
+func formatAllowance(allowance *big.Int, decimals uint8) string {
+	if allowance.Cmp(abi.MaxUint256) == 0 {
+		return "unlimited"
+	}
+	return formatAmount(allowance, decimals)
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// argValue is one value of -args before it is converted to its ABI type:
+// either a scalar in its text form or a list for an array type.
+type argValue struct {
+	text   string
+	list   []argValue
+	isList bool
+}
+
+// parseConstructorArgs converts the values of -args to the Go values the
+// constructor of variant is packed from, checking their number and types
+// against its ABI. raw is either a JSON list, such as
+//
+//	["0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "1000000000000000000000", ["a", "b"]]
+//
+// or a comma-separated list, in which arrays are written in brackets, as in
+// 0x7099...79C8,1000,[a,b]. Strings holding commas or brackets, and lists
+// starting with an array, need the JSON form.
+func parseConstructorArgs(variant tokenVariant, raw string) ([]interface{}, error) {
+	parsed, err := variant.MetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the ABI of %s: %w", variant.Name, err)
+	}
+	inputs := parsed.Constructor.Inputs
+
+	var values []argValue
+	raw = strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(raw, "["):
+		list, err := parseJSONArg(json.RawMessage(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid -args JSON list: %w", err)
+		}
+		values = list.list
+	case raw != "":
+		if values, err = splitArgs(raw); err != nil {
+			return nil, fmt.Errorf("invalid -args: %w", err)
+		}
+	}
+	if len(values) != len(inputs) {
+		return nil, fmt.Errorf("the constructor of %s takes %d arguments (%s), got %d", variant.Name, len(inputs), describeInputs(inputs), len(values))
+	}
+
+	args := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		if args[i], err = convertArg(input.Type, values[i]); err != nil {
+			return nil, fmt.Errorf("invalid constructor argument %s: %w", argLabel(i, input), err)
+		}
+	}
+	return args, nil
+}
+
+// parseJSONArg reads a JSON value of -args. Strings, numbers and booleans
+// become scalars, numbers keeping their exact digits.
+func parseJSONArg(data json.RawMessage) (argValue, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0:
+		return argValue{}, fmt.Errorf("unexpected end of input")
+	case data[0] == '[':
+		var elems []json.RawMessage
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return argValue{}, err
+		}
+		list := argValue{list: []argValue{}, isList: true}
+		for _, elem := range elems {
+			v, err := parseJSONArg(elem)
+			if err != nil {
+				return argValue{}, err
+			}
+			list.list = append(list.list, v)
+		}
+		return list, nil
+	case data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return argValue{}, err
+		}
+		return argValue{text: s}, nil
+	case data[0] == '{' || string(data) == "null":
+		return argValue{}, fmt.Errorf("unsupported value %s", data)
+	}
+	if !json.Valid(data) {
+		return argValue{}, fmt.Errorf("invalid value %s", data)
+	}
+	return argValue{text: string(data)}, nil
+}
+
+// splitArgs splits a comma-separated list of -args, keeping the commas
+// inside brackets, which enclose the elements of an array.
+func splitArgs(raw string) ([]argValue, error) {
+	var values []argValue
+	depth, start := 0, 0
+	for i := 0; i <= len(raw); i++ {
+		if i < len(raw) {
+			switch raw[i] {
+			case '[':
+				depth++
+				continue
+			case ']':
+				if depth--; depth < 0 {
+					return nil, fmt.Errorf("unbalanced ] in %q", raw)
+				}
+				continue
+			case ',':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		if depth > 0 {
+			return nil, fmt.Errorf("unbalanced [ in %q", raw)
+		}
+		field := strings.TrimSpace(raw[start:i])
+		start = i + 1
+		if strings.HasPrefix(field, "[") && strings.HasSuffix(field, "]") {
+			list := argValue{list: []argValue{}, isList: true}
+			if inner := strings.TrimSpace(field[1 : len(field)-1]); inner != "" {
+				elems, err := splitArgs(inner)
+				if err != nil {
+					return nil, err
+				}
+				list.list = elems
+			}
+			values = append(values, list)
+			continue
+		}
+		values = append(values, argValue{text: field})
+	}
+	return values, nil
+}
+
+// convertArg converts v to the Go type the ABI packs as t.
+func convertArg(t abi.Type, v argValue) (interface{}, error) {
+	if t.T != abi.SliceTy && t.T != abi.ArrayTy {
+		if v.isList {
+			return nil, fmt.Errorf("expected a single %s, got a list", t)
+		}
+		return parseArg(t, v.text)
+	}
+	if !v.isList {
+		return nil, fmt.Errorf("expected a list for %s, got %q", t, v.text)
+	}
+	goType := t.GetType()
+	var out reflect.Value
+	if t.T == abi.ArrayTy {
+		if len(v.list) != t.Size {
+			return nil, fmt.Errorf("%s needs %d elements, got %d", t, t.Size, len(v.list))
+		}
+		out = reflect.New(goType).Elem()
+	} else {
+		out = reflect.MakeSlice(goType, len(v.list), len(v.list))
+	}
+	for i, elem := range v.list {
+		value, err := convertArg(*t.Elem, elem)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i+1, err)
+		}
+		out.Index(i).Set(reflect.ValueOf(value))
+	}
+	return out.Interface(), nil
+}
+
+// parseArg converts the text of a scalar value to the Go type the ABI packs
+// as t.
+func parseArg(t abi.Type, value string) (interface{}, error) {
+	switch t.T {
+	case abi.AddressTy:
+		return parseAddress(value)
+	case abi.BoolTy:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not true or false", value)
+		}
+		return b, nil
+	case abi.StringTy:
+		return value, nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("%q is not an integer", value)
+		}
+		if !fitsInt(n, t) {
+			return nil, fmt.Errorf("%s is out of range for %s", value, t)
+		}
+		// Up to 64 bits the ABI uses the sized Go integer types.
+		goType := t.GetType()
+		switch {
+		case goType.Kind() == reflect.Ptr:
+			return n, nil
+		case t.T == abi.UintTy:
+			return reflect.ValueOf(n.Uint64()).Convert(goType).Interface(), nil
+		default:
+			return reflect.ValueOf(n.Int64()).Convert(goType).Interface(), nil
+		}
+	case abi.BytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not 0x-prefixed hex", value)
+		}
+		return b, nil
+	case abi.FixedBytesTy:
+		b, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not 0x-prefixed hex", value)
+		}
+		if len(b) != t.Size {
+			return nil, fmt.Errorf("%s needs %d bytes, got %d", t, t.Size, len(b))
+		}
+		array := reflect.New(t.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(b))
+		return array.Interface(), nil
+	}
+	return nil, fmt.Errorf("type %s is not supported", t)
+}
+
+// fitsInt reports whether n is within the range of the integer type t.
+func fitsInt(n *big.Int, t abi.Type) bool {
+	if t.T == abi.UintTy {
+		return n.Sign() >= 0 && n.BitLen() <= t.Size
+	}
+	// A signed value needs one bit more than its magnitude, except for the
+	// minimum, -2^(size-1).
+	if n.Sign() < 0 {
+		return new(big.Int).Add(n, big.NewInt(1)).BitLen() < t.Size
+	}
+	return n.BitLen() < t.Size
+}
+
+// argLabel names the i-th constructor input for error messages.
+func argLabel(i int, input abi.Argument) string {
+	if input.Name != "" {
+		return fmt.Sprintf("%d (%s %s)", i+1, input.Type, input.Name)
+	}
+	return fmt.Sprintf("%d (%s)", i+1, input.Type)
+}
+
+// describeInputs lists the types and names of inputs, e.g.
+// "string name, uint8 decimals".
+func describeInputs(inputs abi.Arguments) string {
+	if len(inputs) == 0 {
+		return "none"
+	}
+	parts := make([]string, len(inputs))
+	for i, input := range inputs {
+		parts[i] = strings.TrimSpace(input.Type.String() + " " + input.Name)
+	}
+	return strings.Join(parts, ", ")
+}
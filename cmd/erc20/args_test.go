@@ -0,0 +1,142 @@
+package main
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// argsVariant has a constructor taking
+// (address owner, uint256 amount, string[] names, uint8[2][] pairs, int8 offset).
+var argsVariant = tokenVariant{"ArgsToken", &bind.MetaData{ABI: `[{"type":"constructor","inputs":[
+	{"name":"owner","type":"address"},
+	{"name":"amount","type":"uint256"},
+	{"name":"names","type":"string[]"},
+	{"name":"pairs","type":"uint8[2][]"},
+	{"name":"offset","type":"int8"}]}]`}}
+
+const argsOwner = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+func TestParseConstructorArgs(t *testing.T) {
+	maxUint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	tests := []struct {
+		name   string
+		raw    string
+		amount *big.Int
+		names  []string
+		pairs  [][2]uint8
+		offset int8
+	}{
+		{
+			name:   "comma form",
+			raw:    argsOwner + ",1000,[a,b],[[1,2],[3,4]],-128",
+			amount: big.NewInt(1000), names: []string{"a", "b"}, pairs: [][2]uint8{{1, 2}, {3, 4}}, offset: -128,
+		},
+		{
+			name:   "comma form with spaces and empty lists",
+			raw:    " " + argsOwner + " , 0x10 , [ ] , [] , 127 ",
+			amount: big.NewInt(16), names: []string{}, pairs: [][2]uint8{}, offset: 127,
+		},
+		{
+			name:   "JSON form",
+			raw:    `["` + argsOwner + `", "1000", ["a,b", "[c]"], [[1, 2], ["3", 4]], -128]`,
+			amount: big.NewInt(1000), names: []string{"a,b", "[c]"}, pairs: [][2]uint8{{1, 2}, {3, 4}}, offset: -128,
+		},
+		{
+			name:   "largest uint256 as comma value",
+			raw:    argsOwner + "," + maxUint.String() + ",[],[],0",
+			amount: maxUint, names: []string{}, pairs: [][2]uint8{},
+		},
+		{
+			name:   "largest uint256 as JSON number",
+			raw:    `["` + argsOwner + `", ` + maxUint.String() + `, [], [], 0]`,
+			amount: maxUint, names: []string{}, pairs: [][2]uint8{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := parseConstructorArgs(argsVariant, tt.raw)
+			if err != nil {
+				t.Fatalf("parseConstructorArgs() failed: %v", err)
+			}
+			want := []interface{}{common.HexToAddress(argsOwner), tt.amount, tt.names, tt.pairs, tt.offset}
+			if !reflect.DeepEqual(args, want) {
+				t.Errorf("parseConstructorArgs() = %#v, want %#v", args, want)
+			}
+		})
+	}
+}
+
+func TestParseConstructorArgsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		wantErr string
+	}{
+		{name: "no arguments", raw: "", wantErr: "takes 5 arguments (address owner, uint256 amount, string[] names, uint8[2][] pairs, int8 offset), got 0"},
+		{name: "too few", raw: argsOwner + ",1000,[a],[]", wantErr: "takes 5 arguments"},
+		{name: "too many", raw: argsOwner + ",1000,[a],[],1,2", wantErr: "got 6"},
+		{name: "too few in JSON", raw: `["` + argsOwner + `"]`, wantErr: "got 1"},
+		{name: "unbalanced [", raw: argsOwner + ",1000,[a,b,[],0", wantErr: "unbalanced ["},
+		{name: "unbalanced ]", raw: argsOwner + ",1000,a],[],0", wantErr: "unbalanced ]"},
+		{name: "unbalanced nested [", raw: argsOwner + ",1000,[],[[1,2],[3,4],0", wantErr: "unbalanced ["},
+		{name: "invalid JSON", raw: `["` + argsOwner + `", 1000,`, wantErr: "invalid -args JSON list"},
+		{name: "JSON object", raw: `["` + argsOwner + `", {}, [], [], 0]`, wantErr: "unsupported value"},
+		{name: "uint256 overflow", raw: argsOwner + ",115792089237316195423570985008687907853269984665640564039457584007913129639936,[],[],0", wantErr: "out of range for uint256"},
+		{name: "negative uint", raw: argsOwner + ",-1,[],[],0", wantErr: "out of range for uint256"},
+		{name: "int8 below range", raw: argsOwner + ",1,[],[],-129", wantErr: "out of range for int8"},
+		{name: "int8 above range", raw: argsOwner + ",1,[],[],128", wantErr: "out of range for int8"},
+		{name: "not an integer", raw: argsOwner + ",1.5,[],[],0", wantErr: `"1.5" is not an integer`},
+		{name: "list for a scalar", raw: argsOwner + ",[1],[],[],0", wantErr: "expected a single uint256, got a list"},
+		{name: "scalar for a list", raw: argsOwner + ",1,a,[],0", wantErr: "expected a list for string[]"},
+		{name: "fixed array size", raw: argsOwner + ",1,[],[[1,2,3]],0", wantErr: "uint8[2] needs 2 elements, got 3"},
+		{name: "element out of range", raw: argsOwner + ",1,[],[[1,256]],0", wantErr: "element 2: 256 is out of range for uint8"},
+		{name: "invalid address", raw: "0x1234,1,[],[],0", wantErr: "invalid constructor argument 1 (address owner)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := parseConstructorArgs(argsVariant, tt.raw)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseConstructorArgs() = %v, %v, want an error containing %q", args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	scalar := func(s string) argValue { return argValue{text: s} }
+	list := func(elems ...argValue) argValue {
+		return argValue{list: append([]argValue{}, elems...), isList: true}
+	}
+	tests := []struct {
+		raw  string
+		want []argValue
+	}{
+		{raw: "a", want: []argValue{scalar("a")}},
+		{raw: "a, b ,c", want: []argValue{scalar("a"), scalar("b"), scalar("c")}},
+		{raw: "a,,b", want: []argValue{scalar("a"), scalar(""), scalar("b")}},
+		{raw: "a,[b,c]", want: []argValue{scalar("a"), list(scalar("b"), scalar("c"))}},
+		{raw: "a,[[1,2],[3]],[]", want: []argValue{scalar("a"), list(list(scalar("1"), scalar("2")), list(scalar("3"))), list()}},
+		{raw: "a,[[[x]]]", want: []argValue{scalar("a"), list(list(list(scalar("x"))))}},
+	}
+	for _, tt := range tests {
+		got, err := splitArgs(tt.raw)
+		if err != nil {
+			t.Errorf("splitArgs(%q) failed: %v", tt.raw, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitArgs(%q) = %+v, want %+v", tt.raw, got, tt.want)
+		}
+	}
+
+	for _, raw := range []string{"[a", "a]", "a,[b,[c]", "a,[b]]", "]a["} {
+		if got, err := splitArgs(raw); err == nil {
+			t.Errorf("splitArgs(%q) = %+v, want an unbalanced bracket error", raw, got)
+		}
+	}
+}
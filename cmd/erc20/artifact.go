@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var artifactsDir string
+
+// sourceBundle holds what a verification service needs to recompile a
+// deployed contract: the exact compiler version and the standard JSON input
+// the contract was built from.
+type sourceBundle struct {
+	CompilerVersion string          // long solc version, e.g. 0.8.28+commit.7893614a
+	SourceName      string          // e.g. contracts/ERC20Token.sol
+	ContractName    string          // contract name within SourceName
+	Input           json.RawMessage // solc standard JSON input
+	Metadata        string          // solc metadata JSON of the contract, empty when built from sources
+}
+
+// FullyQualifiedName returns the contract name in source:contract form.
+func (b *sourceBundle) FullyQualifiedName() string {
+	return b.SourceName + ":" + b.ContractName
+}
+
+// Sources returns the source files of the bundle keyed by source name.
+func (b *sourceBundle) Sources() (map[string]string, error) {
+	var input struct {
+		Sources map[string]struct {
+			Content string `json:"content"`
+		} `json:"sources"`
+	}
+	if err := json.Unmarshal(b.Input, &input); err != nil {
+		return nil, fmt.Errorf("invalid standard JSON input: %w", err)
+	}
+	sources := make(map[string]string, len(input.Sources))
+	for name, source := range input.Sources {
+		sources[name] = source.Content
+	}
+	return sources, nil
+}
+
+// hardhatBuildInfo is the subset of a Hardhat build-info file we need.
+type hardhatBuildInfo struct {
+	SolcLongVersion string          `json:"solcLongVersion"`
+	Input           json.RawMessage `json:"input"`
+	Output          struct {
+		Contracts map[string]map[string]struct {
+			Metadata string `json:"metadata"`
+			EVM      struct {
+				Bytecode struct {
+					Object string `json:"object"`
+				} `json:"bytecode"`
+			} `json:"evm"`
+		} `json:"contracts"`
+	} `json:"output"`
+}
+
+// loadSourceBundle returns the source bundle of variant: from the Hardhat
+// build-info in dir/build-info that produced its creation bytecode, matched
+// by bytecode so renamed artifacts are still found, or else from the
+// committed sources with the compiler release and settings of
+// contracts/solc.json.
+func loadSourceBundle(dir string, variant tokenVariant) (*sourceBundle, error) {
+	bytecode, err := variant.Bytecode()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "build-info", "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return sourceBundleFromSources(dir, variant.Name)
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read build-info: %w", err)
+		}
+		var info hardhatBuildInfo
+		if err := json.Unmarshal(data, &info); err != nil {
+			return nil, fmt.Errorf("failed to parse build-info %s: %w", file, err)
+		}
+		for sourceName, contracts := range info.Output.Contracts {
+			for contractName, contract := range contracts {
+				if !bytes.Equal(common.FromHex(contract.EVM.Bytecode.Object), bytecode) {
+					continue
+				}
+				return &sourceBundle{
+					CompilerVersion: strings.TrimPrefix(info.SolcLongVersion, "v"),
+					SourceName:      sourceName,
+					ContractName:    contractName,
+					Input:           info.Input,
+					Metadata:        contract.Metadata,
+				}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no build-info in %s matches the deployed bytecode", filepath.Join(dir, "build-info"))
+}
+
+// solcConfig is contracts/solc.json, the compiler release and settings the
+// committed artifacts are built with. hardhat.config.js reads it too.
+type solcConfig struct {
+	Version  string                     `json:"version"` // long solc version
+	Settings map[string]json.RawMessage `json:"settings"`
+}
+
+var (
+	importPattern   = regexp.MustCompile(`(?m)^\s*import\s+(?:[^"';]*\s+from\s+)?["']([^"']+)["']`)
+	contractPattern = regexp.MustCompile(`(?m)^\s*contract\s+(\w+)`)
+)
+
+// sourceBundleFromSources builds the standard JSON input of the contract name
+// from contracts/<name>.sol in the project holding the artifacts dir and the
+// files it imports, read from node_modules for packages. Sources are named
+// relative to the project root as Hardhat names them, so the input is the
+// one Hardhat compiled.
+func sourceBundleFromSources(dir, name string) (*sourceBundle, error) {
+	root := filepath.Dir(filepath.Dir(dir))
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(dir), "solc.json"))
+	if err != nil {
+		return nil, fmt.Errorf("no Hardhat build-info in %s and no compiler settings: %w", filepath.Join(dir, "build-info"), err)
+	}
+	var config solcConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse compiler settings: %w", err)
+	}
+
+	sourceName := "contracts/" + name + ".sol"
+	sources := make(map[string]map[string]string)
+	queue := []string{sourceName}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if _, ok := sources[current]; ok {
+			continue
+		}
+		file := filepath.Join(root, filepath.FromSlash(current))
+		if !strings.HasPrefix(current, "contracts/") {
+			file = filepath.Join(root, "node_modules", filepath.FromSlash(current))
+		}
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read source %s, install the contract dependencies with npm install: %w", current, err)
+		}
+		sources[current] = map[string]string{"content": string(content)}
+		for _, m := range importPattern.FindAllStringSubmatch(string(content), -1) {
+			imported := m[1]
+			if strings.HasPrefix(imported, "./") || strings.HasPrefix(imported, "../") {
+				imported = path.Join(path.Dir(current), imported)
+			}
+			queue = append(queue, imported)
+		}
+	}
+
+	// The contract is usually named after its file, otherwise it is the only
+	// one in it.
+	contractName := ""
+	declared := contractPattern.FindAllStringSubmatch(sources[sourceName]["content"], -1)
+	for _, m := range declared {
+		if m[1] == name {
+			contractName = name
+		}
+	}
+	if contractName == "" && len(declared) == 1 {
+		contractName = declared[0][1]
+	}
+	if contractName == "" {
+		return nil, fmt.Errorf("%s declares no contract %s", sourceName, name)
+	}
+
+	settings := config.Settings
+	if settings == nil {
+		settings = make(map[string]json.RawMessage)
+	}
+	if _, ok := settings["outputSelection"]; !ok {
+		settings["outputSelection"] = json.RawMessage(`{"*":{"*":["abi","evm.bytecode","evm.deployedBytecode","metadata"]}}`)
+	}
+	input, err := json.Marshal(map[string]interface{}{
+		"language": "Solidity",
+		"sources":  sources,
+		"settings": settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sourceBundle{
+		CompilerVersion: strings.TrimPrefix(config.Version, "v"),
+		SourceName:      sourceName,
+		ContractName:    contractName,
+		Input:           input,
+	}, nil
+}
+
+// contractArtifact covers the compiler outputs -artifact accepts: a Hardhat
+// artifact, whose bytecode is a string, a Foundry one, whose bytecode is an
+// object, and solc --combined-json abi,bin output holding several contracts.
+type contractArtifact struct {
+	ContractName string          `json:"contractName"`
+	ABI          json.RawMessage `json:"abi"`
+	Bytecode     json.RawMessage `json:"bytecode"`
+	Contracts    map[string]struct {
+		ABI json.RawMessage `json:"abi"`
+		Bin string          `json:"bin"`
+	} `json:"contracts"`
+}
+
+// loadContractArtifact reads the ABI and creation bytecode of a compiled
+// contract from spec, the path of an artifact file. A combined-json file with
+// several contracts needs the contract name appended, as in
+// combined.json:MyContract.
+func loadContractArtifact(spec string) (tokenVariant, error) {
+	contract, err := readContractArtifact(spec)
+	if err != nil {
+		return tokenVariant{}, err
+	}
+	if contract.MetaData.Bin == "" {
+		return tokenVariant{}, fmt.Errorf("%s has no bytecode, it is abstract or an interface", contract.Name)
+	}
+	return contract, nil
+}
+
+// readContractArtifact is loadContractArtifact for contracts that may have no
+// bytecode, such as interfaces; their MetaData.Bin is empty.
+func readContractArtifact(spec string) (tokenVariant, error) {
+	path, contract := spec, ""
+	if _, err := os.Stat(path); err != nil {
+		if i := strings.LastIndex(spec, ":"); i > 0 {
+			path, contract = spec[:i], spec[i+1:]
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return tokenVariant{}, fmt.Errorf("failed to read artifact: %w", err)
+	}
+	var artifact contractArtifact
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return tokenVariant{}, fmt.Errorf("failed to parse artifact %s: %w", path, err)
+	}
+
+	var name string
+	var abiJSON json.RawMessage
+	var bin string
+	if artifact.Contracts != nil {
+		var matches []string
+		for key := range artifact.Contracts {
+			short := key[strings.LastIndex(key, ":")+1:]
+			if contract == "" || contract == short || contract == key {
+				matches = append(matches, key)
+			}
+		}
+		if len(matches) > 1 {
+			// Interfaces and abstract contracts have no bytecode, a single
+			// contract with bytecode is the one meant.
+			var deployable []string
+			for _, key := range matches {
+				if artifact.Contracts[key].Bin != "" {
+					deployable = append(deployable, key)
+				}
+			}
+			if len(deployable) == 1 {
+				matches = deployable
+			}
+		}
+		switch {
+		case len(matches) == 0 && contract != "":
+			return tokenVariant{}, fmt.Errorf("artifact %s has no contract %s", path, contract)
+		case len(matches) == 0:
+			return tokenVariant{}, fmt.Errorf("artifact %s has no contracts", path)
+		case len(matches) > 1:
+			sort.Strings(matches)
+			return tokenVariant{}, fmt.Errorf("artifact %s has several contracts, pick one with %s:<name>: %s", path, path, strings.Join(matches, ", "))
+		}
+		key := matches[0]
+		name, abiJSON, bin = key[strings.LastIndex(key, ":")+1:], artifact.Contracts[key].ABI, artifact.Contracts[key].Bin
+		// Older solc versions give the ABI as a JSON string.
+		var s string
+		if json.Unmarshal(abiJSON, &s) == nil {
+			abiJSON = json.RawMessage(s)
+		}
+	} else {
+		name, abiJSON = artifact.ContractName, artifact.ABI
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &bin); err != nil {
+			var foundry struct {
+				Object string `json:"object"`
+			}
+			if err := json.Unmarshal(artifact.Bytecode, &foundry); err != nil {
+				return tokenVariant{}, fmt.Errorf("artifact %s has no bytecode", path)
+			}
+			bin = foundry.Object
+		}
+	}
+
+	if len(abiJSON) == 0 {
+		return tokenVariant{}, fmt.Errorf("artifact %s has no ABI", path)
+	}
+	if _, err := abi.JSON(bytes.NewReader(abiJSON)); err != nil {
+		return tokenVariant{}, fmt.Errorf("artifact %s has an invalid ABI: %w", path, err)
+	}
+	bin = strings.TrimPrefix(bin, "0x")
+	if strings.Contains(bin, "__") {
+		return tokenVariant{}, fmt.Errorf("%s has unlinked library references, link the libraries before deploying it", name)
+	}
+	if _, err := hex.DecodeString(bin); err != nil {
+		return tokenVariant{}, fmt.Errorf("artifact %s has invalid bytecode: %w", path, err)
+	}
+	if bin == "" {
+		return tokenVariant{Name: name, MetaData: &bind.MetaData{ABI: string(abiJSON)}}, nil
+	}
+	return tokenVariant{Name: name, MetaData: &bind.MetaData{ABI: string(abiJSON), Bin: "0x" + bin}}, nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// attestation is an EIP-712 signed statement by the deployer that it
+// launched a token. The signed TokenLaunch struct is made of the fields
+// before Signature, under a domain holding only the tool name, version and
+// chain ID, see the README for the schema.
+type attestation struct {
+	Token     string        `json:"token"`
+	Deployer  string        `json:"deployer"`
+	Name      string        `json:"name"`
+	Symbol    string        `json:"symbol"`
+	Timestamp uint64        `json:"timestamp"`
+	ChainID   uint64        `json:"chainId"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// attestDeployment signs an attestation of the token deployed to address in
+// receipt. Its timestamp is that of the deployment block.
+func attestDeployment(ctx context.Context, client *ethclient.Client, account *signer, plan *deployPlan, address common.Address, receipt *types.Receipt) (*attestation, error) {
+	if account.key == nil {
+		return nil, fmt.Errorf("signing requires a private key")
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	header, err := client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the deployment block: %w", err)
+	}
+	a := &attestation{
+		Token:     address.Hex(),
+		Deployer:  account.address.Hex(),
+		Name:      plan.name,
+		Symbol:    plan.symbol,
+		Timestamp: header.Time,
+		ChainID:   chainID.Uint64(),
+	}
+	if err := a.sign(account.key); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// typedData returns the EIP-712 typed data signed by the attestation.
+func (a *attestation) typedData() apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			"TokenLaunch": {
+				{Name: "token", Type: "address"},
+				{Name: "deployer", Type: "address"},
+				{Name: "name", Type: "string"},
+				{Name: "symbol", Type: "string"},
+				{Name: "timestamp", Type: "uint256"},
+			},
+		},
+		PrimaryType: "TokenLaunch",
+		Domain: apitypes.TypedDataDomain{
+			Name:    "tokken",
+			Version: "1",
+			ChainId: (*math.HexOrDecimal256)(new(big.Int).SetUint64(a.ChainID)),
+		},
+		Message: apitypes.TypedDataMessage{
+			"token":     a.Token,
+			"deployer":  a.Deployer,
+			"name":      a.Name,
+			"symbol":    a.Symbol,
+			"timestamp": fmt.Sprint(a.Timestamp),
+		},
+	}
+}
+
+// sign sets the signature of the attestation, made with the deployer key.
+// Like permit signatures, v is 27 or 28.
+func (a *attestation) sign(key *ecdsa.PrivateKey) error {
+	hash, _, err := apitypes.TypedDataAndHash(a.typedData())
+	if err != nil {
+		return err
+	}
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		return err
+	}
+	sig[64] += 27
+	a.Signature = sig
+	return nil
+}
+
+// recoverSigner returns the address that signed the attestation.
+func (a *attestation) recoverSigner() (common.Address, error) {
+	if len(a.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(a.Signature))
+	}
+	hash, _, err := apitypes.TypedDataAndHash(a.typedData())
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := common.CopyBytes(a.Signature)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+func runVerifyAttestation(args []string) {
+	fs := newFlagSet("verify-attestation")
+	file := fs.String("file", "", "JSON file holding an attestation, on its own or as the attestation field of deploy -json or -out output")
+	fs.Parse(args)
+
+	if *file == "" {
+		fatal("The -file flag is required")
+	}
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		fatalf("Failed to read attestation: %v", err)
+	}
+	var wrapper struct {
+		Attestation *attestation `json:"attestation"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		fatalf("Failed to parse attestation: %v", err)
+	}
+	a := wrapper.Attestation
+	if a == nil {
+		a = new(attestation)
+		if err := json.Unmarshal(data, a); err != nil {
+			fatalf("Failed to parse attestation: %v", err)
+		}
+	}
+	if !common.IsHexAddress(a.Token) || !common.IsHexAddress(a.Deployer) {
+		fatalf("%s holds no attestation with token and deployer addresses", *file)
+	}
+
+	signer, err := a.recoverSigner()
+	if err != nil {
+		fatalf("Failed to recover signer: %v", err)
+	}
+	fmt.Printf("Token: %s (%s, %s)\n", common.HexToAddress(a.Token).Hex(), a.Name, a.Symbol)
+	fmt.Printf("Chain ID: %d\n", a.ChainID)
+	fmt.Printf("Deployer: %s\n", common.HexToAddress(a.Deployer).Hex())
+	fmt.Printf("Signer: %s\n", signer.Hex())
+	if signer != common.HexToAddress(a.Deployer) {
+		fatalfCode(exitFailure, "Attestation is NOT valid: it was not signed by the deployer")
+	}
+	fmt.Printf("Attestation is valid.\n")
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// addressList is a flag.Value collecting addresses from repeated or
+// comma-separated flag values. They are parsed once all flags are, so that
+// -strict applies wherever it is given.
+type addressList []string
+
+func (l *addressList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *addressList) Set(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		*l = append(*l, strings.TrimSpace(part))
+	}
+	return nil
+}
+
+// parse returns the addresses of the list.
+func (l addressList) parse() ([]common.Address, error) {
+	addresses := make([]common.Address, len(l))
+	for i, s := range l {
+		address, err := parseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = address
+	}
+	return addresses, nil
+}
+
+func runBalance(args []string) {
+	fs := newFlagSet("balance")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	var addressFlag addressList
+	fs.Var(&addressFlag, "address", "Address to query (repeatable or comma-separated)")
+	concurrency := fs.Int("concurrency", 8, "Number of balances queried at the same time")
+	block := addBlockFlag(fs)
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || len(addressFlag) == 0 {
+		fatal("All flags are required: -rpc (or -network), -contract, -address")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	addresses, err := addressFlag.parse()
+	if err != nil {
+		fatalf("Invalid address: %v", err)
+	}
+	if *concurrency < 1 {
+		fatal("The -concurrency must be at least 1")
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	// Every balance is read at the same block, so the table is consistent
+	// even if transfers land while it is queried.
+	opts, err := block.callOpts(ctx, client)
+	if err != nil {
+		fatal(err)
+	}
+	decimals, err := instance.Decimals(opts)
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	balances, errs := queryBalances(ctx, instance, opts, addresses, *concurrency)
+
+	fmt.Printf("Block: %s\n", describeBlock(opts))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tBALANCE\tRAW")
+	failed := 0
+	for i, addr := range addresses {
+		if errs[i] != nil {
+			failed++
+			fmt.Fprintf(w, "%s\terror: %v\t-\n", addr.Hex(), errs[i])
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", addr.Hex(), formatAmount(balances[i], decimals), balances[i])
+	}
+	w.Flush()
+	if failed > 0 {
+		fatalfCode(exitFailure, "Failed to query %d of %d balances", failed, len(addresses))
+	}
+}
+
+// queryBalances reads the balances of addresses with up to concurrency
+// calls in flight. The results are in the order of addresses; a balance
+// that could not be read has its error set instead.
+func queryBalances(ctx context.Context, instance *ERC20Token, opts *bind.CallOpts, addresses []common.Address, concurrency int) ([]*big.Int, []error) {
+	balances := make([]*big.Int, len(addresses))
+	errs := make([]error, len(addresses))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(addresses)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				balances[i], errs[i] = withRetry(ctx, "query balance", func() (*big.Int, error) {
+					return instance.BalanceOf(opts, addresses[i])
+				})
+			}
+		}()
+	}
+	for i := range addresses {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return balances, errs
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func runGenerateBindings(args []string) {
+	fs := newFlagSet("generate-bindings")
+	artifactPath := fs.String("artifact", "", "Compiled contract to bind: a Hardhat or Foundry artifact, or solc --combined-json output with :Name appended to pick the contract")
+	pkg := fs.String("pkg", "main", "Go package of the generated file")
+	typeName := fs.String("type", "", "Go type of the binding (default: the contract name)")
+	out := fs.String("out", "", "File to write the bindings to (default: stdout)")
+	abiOnly := fs.Bool("abi-only", false, "Leave the bytecode out, as the token variants do, which load it from -artifacts when deployed")
+	fs.Parse(args)
+
+	if *artifactPath == "" {
+		fatal("The -artifact flag is required")
+	}
+	if !token.IsIdentifier(*pkg) {
+		fatalf("Invalid package name: %s", *pkg)
+	}
+	contract, err := readContractArtifact(*artifactPath)
+	if err != nil {
+		fatal(err)
+	}
+	if *typeName == "" {
+		*typeName = contract.Name
+	}
+	if !token.IsIdentifier(*typeName) {
+		fatalf("Invalid type name %q, set one with -type", *typeName)
+	}
+
+	code, err := generateBindings(contract, *typeName, *pkg, *abiOnly)
+	if err != nil {
+		fatal(err)
+	}
+	if *out == "" {
+		fmt.Print(code)
+		return
+	}
+	if err := os.WriteFile(*out, []byte(code), 0o644); err != nil {
+		fatalf("Failed to write bindings: %v", err)
+	}
+	fmt.Printf("Bindings for %s written to %s\n", *typeName, *out)
+}
+
+// generateBindings returns the abigen Go bindings of contract as the type
+// typeName in package pkg. The same artifact always gives the same file.
+func generateBindings(contract tokenVariant, typeName, pkg string, abiOnly bool) (string, error) {
+	bin := contract.MetaData.Bin
+	if abiOnly {
+		bin = ""
+	}
+	code, err := bind.Bind([]string{typeName}, []string{contract.MetaData.ABI}, []string{bin}, nil, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate bindings for %s: %w", contract.Name, err)
+	}
+	return code, nil
+}
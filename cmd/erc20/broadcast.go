@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// broadcastClients are the -rpcs endpoints besides the one dialClient
+// returned. Signed transactions are sent to them as well.
+var broadcastClients []*ethclient.Client
+
+// urlListFlag is the -rpcs flag. It also sets rpcURL to the first URL, so
+// commands that require -rpc accept -rpcs in its place.
+type urlListFlag struct{}
+
+func (urlListFlag) String() string {
+	return strings.Join(rpcURLs, ",")
+}
+
+func (urlListFlag) Set(value string) error {
+	rpcURLs = nil
+	for _, url := range strings.Split(value, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			rpcURLs = append(rpcURLs, url)
+		}
+	}
+	if len(rpcURLs) == 0 {
+		return fmt.Errorf("no RPC URLs given")
+	}
+	rpcURL = rpcURLs[0]
+	return nil
+}
+
+// broadcastTransaction sends the signed tx to client and every broadcast
+// client at once and returns when the first of them accepts it. All
+// endpoints get the same signed transaction, so the hash doesn't change and
+// the ones that already heard of it from their peers report "already known",
+// which counts as accepted.
+func broadcastTransaction(ctx context.Context, client *ethclient.Client, tx *types.Transaction) error {
+	clients := append([]*ethclient.Client{client}, broadcastClients...)
+	results := make(chan error, len(clients))
+	for _, c := range clients {
+		go func() {
+			results <- sendTransactionTo(ctx, c, tx)
+		}()
+	}
+
+	var failures []error
+	for range clients {
+		err := <-results
+		if err == nil {
+			return nil
+		}
+		failures = append(failures, err)
+	}
+	return errors.Join(failures...)
+}
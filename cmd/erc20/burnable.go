@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployBurnableERC20Token deploys a new BurnableERC20Token contract and binds
+// an instance of it.
+func DeployBurnableERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *BurnableERC20Token, error) {
+	address, tx, err := burnableToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewBurnableERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runBurn(args []string) {
+	fs := newFlagSet("burn")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -burnable")
+	amount := fs.String("amount", "", "Amount of tokens to burn (in whole units)")
+	from := fs.String("from", "", "Burn from this address using the account's allowance instead of its own balance")
+	verifyEffects := fs.Bool("verify-effects", false, "Check that the total supply dropped by the burned amount")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -amount")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if *from != "" {
+		if _, err := parseAddress(*from); err != nil {
+			fatalf("Invalid -from address: %v", err)
+		}
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	token, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := tokenDecimals(ctx, client, common.HexToAddress(*contract))
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	var supplyBefore *big.Int
+	if *verifyEffects {
+		supplyBefore, err = token.TotalSupply(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			fatalf("Failed to query total supply: %v", err)
+		}
+	}
+
+	instance, err := NewBurnableERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "burn "+*amount+" tokens"); err != nil {
+		fatal(err)
+	}
+
+	var tx *types.Transaction
+	if *from != "" {
+		tx, err = instance.BurnFrom(auth, common.HexToAddress(*from), value)
+	} else {
+		tx, err = instance.Burn(auth, value)
+	}
+	if err != nil {
+		fatal(txError("burn", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Burn")
+	if err != nil {
+		fatalf("Burn failed: %v", err)
+	}
+
+	if *verifyEffects && receipt.Status == 1 {
+		// Read the supply at the burn's block so later transactions don't skew it.
+		supplyAfter, err := token.TotalSupply(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber})
+		if err != nil {
+			fatalf("Failed to query total supply: %v", err)
+		}
+		burned := new(big.Int).Sub(supplyBefore, supplyAfter)
+		fmt.Printf("Total supply: %s -> %s\n", formatAmount(supplyBefore, decimals), formatAmount(supplyAfter, decimals))
+		if burned.Cmp(value) != 0 {
+			fatalfCode(exitFailure, "Total supply dropped by %s, expected %s", formatAmount(burned, decimals), *amount)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployCappedERC20Token deploys a new mintable CappedERC20Token contract,
+// owned by the deploying account, whose total supply can never exceed cap.
+func DeployCappedERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int, cap *big.Int) (common.Address, *types.Transaction, *CappedERC20Token, error) {
+	address, tx, err := cappedToken.Deploy(auth, backend, name, symbol, decimals, supply, cap)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewCappedERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/external"
+)
+
+// openExternalSigner connects to the external signer, such as clef, at url
+// and selects the account to sign with: address, or the signer's only
+// account if address is empty. Keys stay with the signer, which asks its
+// operator to approve every request.
+func openExternalSigner(url, address string) (*signer, error) {
+	remote, err := external.NewExternalSigner(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the external signer at %s: %w", url, err)
+	}
+	slog.Info("Listing the accounts of the external signer, approve the request if it asks")
+	// Accounts logs and swallows errors, so an empty list may also be a
+	// denied or failed request.
+	available := remote.Accounts()
+
+	if address == "" {
+		switch len(available) {
+		case 0:
+			return nil, fmt.Errorf("the external signer listed no accounts, the request may have been denied")
+		case 1:
+			return &signer{address: available[0].Address, wallet: remote, account: available[0], external: true}, nil
+		}
+		listed := make([]string, len(available))
+		for i, account := range available {
+			listed[i] = account.Address.Hex()
+		}
+		return nil, fmt.Errorf("the external signer has %d accounts, pick one with -signer-account: %s", len(available), strings.Join(listed, ", "))
+	}
+
+	from, err := parseAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid -signer-account: %w", err)
+	}
+	for _, account := range available {
+		if account.Address == from {
+			return &signer{address: from, wallet: remote, account: account, external: true}, nil
+		}
+	}
+	return nil, fmt.Errorf("the external signer does not manage %s, or denied listing its accounts", from.Hex())
+}
+
+// externalSignError turns the error of a signing request the external
+// signer refused or failed into an actionable message.
+func externalSignError(err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "request denied"):
+		return fmt.Errorf("the transaction was rejected in the external signer")
+	case strings.Contains(msg, "rules"), strings.Contains(msg, "not allowed"):
+		return fmt.Errorf("the external signer's rules refused the transaction: %w", err)
+	case strings.Contains(msg, "connection refused"), strings.Contains(msg, "eof"):
+		return fmt.Errorf("lost the connection to the external signer: %w", err)
+	}
+	return fmt.Errorf("external signing failed: %w", err)
+}
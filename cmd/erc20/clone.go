@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// cloneInitCode returns the EIP-1167 creation code of a minimal proxy that
+// delegates every call to implementation. It deploys 45 bytes of runtime
+// code with the implementation address embedded.
+func cloneInitCode(implementation common.Address) []byte {
+	code := common.FromHex("0x3d602d80600a3d3981f3363d3d373d3d3d363d73")
+	code = append(code, implementation.Bytes()...)
+	return append(code, common.FromHex("0x5af43d82803e903d91602b57fd5bf3")...)
+}
+
+// checkImplementation makes sure the -clone-of implementation is deployed,
+// since a clone of an address without code does nothing.
+func checkImplementation(ctx context.Context, client *ethclient.Client, implementation common.Address) error {
+	code, err := client.CodeAt(ctx, implementation, nil)
+	if err != nil {
+		return fmt.Errorf("failed to check the implementation: %w", err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("the implementation %s has no code on this chain", implementation.Hex())
+	}
+	return nil
+}
+
+// initializeClone calls initialize on a freshly created clone with the token
+// parameters of plan. auth must still hold the nonce of the creation, which
+// is advanced first. Until this call is mined anyone could initialize the
+// clone, in which case it reverts and the clone has to be abandoned.
+func initializeClone(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, plan *deployPlan, clone common.Address, progress io.Writer) (*types.Receipt, error) {
+	instance, err := NewInitializableERC20Token(clone, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind clone: %w", err)
+	}
+	auth.Nonce.Add(auth.Nonce, common.Big1)
+	// The gas limit was estimated for the creation, let the binding estimate
+	// the initialize call instead.
+	auth.GasLimit = 0
+	tx, err := instance.Initialize(auth, plan.name, plan.symbol, plan.decimals, plan.supply)
+	if err != nil {
+		return nil, fmt.Errorf("clone %s was created but %w", clone.Hex(), txError("initialize it", err))
+	}
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return nil, fmt.Errorf("failed to initialize clone: %w", err)
+	}
+	fmt.Fprintf(progress, "Initializing clone, transaction hash: %s\n", tx.Hash().Hex())
+
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for initialization: %w", err)
+	}
+	if receipt.Status != 1 {
+		return nil, withExitCode(exitReverted, fmt.Errorf("clone %s was created but initialize failed: %s", clone.Hex(), receiptRevertReason(ctx, client, tx, receipt)))
+	}
+	return receipt, nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseWithConfig parses args into fs and then fills every flag that was not
+// given on the command line from the YAML file named by -config, if any.
+// Config keys are flag names, so command line flags override file values,
+// which override the flag defaults.
+func parseWithConfig(fs *flag.FlagSet, args []string) error {
+	configPath := fs.String("config", "", "Path to a YAML file with default flag values")
+	fs.Parse(args)
+	if *configPath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(*configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	var values map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to parse config %s: %w", *configPath, err)
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+	for key, node := range values {
+		if key == "config" || fs.Lookup(key) == nil {
+			return fmt.Errorf("unknown key %q in config %s", key, *configPath)
+		}
+		if node.Kind != yaml.ScalarNode {
+			return fmt.Errorf("config key %q must be a single value", key)
+		}
+		if explicit[key] {
+			continue
+		}
+		if err := fs.Set(key, node.Value); err != nil {
+			return fmt.Errorf("invalid value for config key %q: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// confirmBroadcast guards every command that sends a real transaction. It
+// checks the endpoint's chain ID against -chainid, prints the chain the
+// transaction is about to be sent to and, unless -yes is given, asks the user
+// to confirm.
+func confirmBroadcast(ctx context.Context, w io.Writer, client *ethclient.Client, action string) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	if expectedChainID != 0 && chainID.Int64() != expectedChainID {
+		return fmt.Errorf("chain ID mismatch: expected %d but the RPC endpoint reports %s", expectedChainID, chainID)
+	}
+
+	fmt.Fprintf(w, "Network: %s (chain ID %s)\n", chainName(chainID), chainID)
+	if assumeYes {
+		return nil
+	}
+	if !stdinIsTerminal() {
+		return fmt.Errorf("confirmation required, pass -yes to %s non-interactively", action)
+	}
+
+	fmt.Fprintf(os.Stderr, "Proceed to %s on this network? [y/N]: ", action)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && answer == "" {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	}
+	return fmt.Errorf("aborted by user")
+}
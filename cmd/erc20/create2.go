@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// deploymentProxy is the deterministic deployment proxy that exists at this
+// address on most EVM chains, see
+// https://github.com/Arachnid/deterministic-deployment-proxy. Its call data is
+// a 32-byte salt followed by the init code to deploy with CREATE2.
+var deploymentProxy = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// create2FactoryCode is the init code of contracts/Create2Factory.sol. It is
+// hand-assembled rather than compiled so that it, and with it the factory
+// address, never changes.
+const create2FactoryCode = "0x6100e48061000d6000396000f3602036106100da576020360380602060803733600052600035602052604060002090608034f580156100da576370a0823160e01b600052306004526020600060246000845afa156100da5760005180156100815763a9059cbb60e01b600052336004528060245260206000604460006000865af1156100da57600051156100da575b50638da5cb5b60e01b6000526020600060046000845afa156100d1573d6020116100d1576000513014156100d15763f2fde38b60e01b6000523360045260006000602460006000855af1156100da575b60005260206000f35b3d6000803e3d6000fd"
+
+// defaultCreate2Factory returns the address of the factory when it is
+// deployed through deploymentProxy with a zero salt, which is the same on
+// every chain that has the proxy.
+func defaultCreate2Factory() common.Address {
+	return crypto.CreateAddress2(deploymentProxy, [32]byte{}, crypto.Keccak256(common.FromHex(create2FactoryCode)))
+}
+
+// parseSalt parses a -salt value. Hex values of up to 32 bytes are left-padded
+// and used as is, any other text is hashed with keccak256.
+func parseSalt(value string) ([32]byte, error) {
+	var salt [32]byte
+	if value == "" {
+		return salt, fmt.Errorf("the -salt flag is required with -create2")
+	}
+	if strings.HasPrefix(value, "0x") {
+		b, err := hexutil.Decode(value)
+		if err != nil || len(b) > 32 {
+			return salt, fmt.Errorf("invalid -salt %q, expected up to 32 bytes as an even number of hex digits", value)
+		}
+		copy(salt[32-len(b):], b)
+		return salt, nil
+	}
+	return crypto.Keccak256Hash([]byte(value)), nil
+}
+
+// predictCreate2Address returns the address the factory deploys initCode to
+// when called by deployer with salt. The factory mixes the caller into the
+// salt as keccak256(abi.encode(caller, salt)).
+func predictCreate2Address(factory, deployer common.Address, salt [32]byte, initCode []byte) common.Address {
+	mixed := crypto.Keccak256Hash(common.LeftPadBytes(deployer.Bytes(), 32), salt[:])
+	return crypto.CreateAddress2(factory, mixed, crypto.Keccak256(initCode))
+}
+
+// create2Calldata returns the factory call data deploying initCode with salt.
+func create2Calldata(salt [32]byte, initCode []byte) []byte {
+	return append(salt[:], initCode...)
+}
+
+// create2Transact builds and signs, but does not send, a call of the factory.
+func create2Transact(auth *bind.TransactOpts, client *ethclient.Client, factory common.Address, data []byte) (*types.Transaction, error) {
+	return bind.NewBoundContract(factory, abi.ABI{}, client, client, client).RawTransact(auth, data)
+}
+
+// ensureCreate2Factory makes sure the factory has code. The default factory
+// is deployed through deploymentProxy when it is missing, which uses up the
+// nonce in auth, so auth.Nonce is advanced and true is returned.
+func ensureCreate2Factory(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, factory common.Address) (bool, error) {
+	code, err := client.CodeAt(ctx, factory, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to read factory code: %w", err)
+	}
+	if len(code) > 0 {
+		return false, nil
+	}
+	if factory != defaultCreate2Factory() {
+		return false, fmt.Errorf("no contract at -factory %s", factory.Hex())
+	}
+	code, err = client.CodeAt(ctx, deploymentProxy, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to read deployment proxy code: %w", err)
+	}
+	if len(code) == 0 {
+		return false, fmt.Errorf("the deterministic deployment proxy %s is not deployed on this chain, deploy it first (see https://github.com/Arachnid/deterministic-deployment-proxy) or pass -factory", deploymentProxy.Hex())
+	}
+
+	slog.Info("Deploying the CREATE2 factory", "address", factory.Hex())
+	opts := *auth
+	opts.GasLimit = 0 // estimated by the binding
+	tx, err := create2Transact(&opts, client, deploymentProxy, create2Calldata([32]byte{}, common.FromHex(create2FactoryCode)))
+	if err != nil {
+		return false, txError("deploy the CREATE2 factory", err)
+	}
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return false, fmt.Errorf("failed to deploy the CREATE2 factory: %w", err)
+	}
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return false, fmt.Errorf("failed to deploy the CREATE2 factory: %w", err)
+	}
+	if receipt.Status != 1 {
+		return false, withExitCode(exitReverted, fmt.Errorf("CREATE2 factory deployment %s failed: %s", tx.Hash().Hex(), receiptRevertReason(ctx, client, tx, receipt)))
+	}
+	auth.Nonce.Add(auth.Nonce, common.Big1)
+	return true, nil
+}
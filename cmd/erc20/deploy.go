@@ -0,0 +1,993 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+var gasBuffer uint64
+
+// deployResultSchemaVersion is bumped whenever the fields of deployResult
+// change incompatibly.
+const deployResultSchemaVersion = 1
+
+// deployResult is the -json output of the deploy command.
+type deployResult struct {
+	SchemaVersion   int          `json:"schemaVersion"`
+	ContractAddress string       `json:"contractAddress"`
+	TransactionHash string       `json:"transactionHash"`
+	Status          uint64       `json:"status"`
+	RevertReason    string       `json:"revertReason,omitempty"`
+	GasUsed         uint64       `json:"gasUsed"`
+	GasCost         string       `json:"gasCost,omitempty"` // in the native currency
+	Name            string       `json:"name,omitempty"`
+	Symbol          string       `json:"symbol,omitempty"`
+	Decimals        *uint8       `json:"decimals,omitempty"`
+	Cap             string       `json:"cap,omitempty"`
+	Granularity     string       `json:"granularity,omitempty"`
+	Restricted      bool         `json:"restricted,omitempty"`
+	Wrapped         bool         `json:"wrapped,omitempty"`
+	TaxBps          *uint16      `json:"taxBps,omitempty"`
+	Treasury        string       `json:"treasury,omitempty"`
+	ProxyType       string       `json:"proxyType,omitempty"`
+	Implementation  string       `json:"implementation,omitempty"`
+	ProxyAdmin      string       `json:"proxyAdmin,omitempty"`
+	Operators       []string     `json:"defaultOperators,omitempty"`
+	TotalSupply     string       `json:"totalSupply,omitempty"`
+	Owner           string       `json:"owner,omitempty"`
+	Deployer        string       `json:"deployer,omitempty"`
+	DeployerBalance string       `json:"deployerBalance,omitempty"`
+	Attestation     *attestation `json:"attestation,omitempty"`
+	Network         string       `json:"network,omitempty"`
+	Error           string       `json:"error,omitempty"`
+}
+
+func runDeploy(args []string) {
+	fs := newFlagSet("deploy")
+	fs.Usage = func() {
+		printCommands(fs.Output())
+		fmt.Fprintf(fs.Output(), "\nDeploy flags:\n")
+		fs.PrintDefaults()
+	}
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	tokenName := fs.String("name", "", "Name of the token")
+	tokenSymbol := fs.String("symbol", "", "Symbol of the token")
+	tokenDecimals := fs.Uint("decimals", 18, "Number of decimals for the token")
+	totalSupply := fs.String("supply", "", "Total supply of tokens (in whole units)")
+	fs.Uint64Var(&gasLimit, "gas", 3000000, "Gas limit for deployment, used if gas estimation fails")
+	fs.Uint64Var(&gasBuffer, "gasbuffer", 20, "Percentage added on top of the estimated deployment gas")
+	fs.Lookup("force").Usage = "Deploy even if -decimals is above 18, or on a chain without EIP-155 replay protection (chain ID 0)"
+	dryRun := fs.Bool("dryrun", false, "Simulate the deployment without broadcasting it")
+	overrideFlag := fs.String("state-override", "", "State override for -dryrun, as inline JSON or a JSON file mapping addresses to {balance, nonce, code, state, stateDiff}")
+	jsonOutput := fs.Bool("json", false, "Print the result as a single JSON object on stdout")
+	verify := fs.Bool("verify", false, "Verify the contract source on Etherscan after deployment")
+	fs.StringVar(&etherscanAPIKey, "etherscan-apikey", "", "Etherscan API key, required with -verify")
+	fs.StringVar(&etherscanURL, "etherscan-url", "https://api.etherscan.io/v2/api", "Etherscan v2 API endpoint")
+	verifySourcify := fs.Bool("verify-sourcify", false, "Verify the contract source on Sourcify after deployment")
+	attest := fs.Bool("attest", false, "Sign an EIP-712 attestation of the launch with the deployer key, included in -json and -out output")
+	fs.StringVar(&sourcifyURL, "sourcify-url", "https://sourcify.dev/server", "Sourcify server URL")
+	mintable := fs.Bool("mintable", false, "Deploy the mintable variant, letting the deployer mint new tokens")
+	burnable := fs.Bool("burnable", false, "Deploy the burnable variant, letting holders burn their tokens")
+	supplyCap := fs.String("cap", "", "Maximum total supply (in whole units), requires -mintable")
+	pausable := fs.Bool("pausable", false, "Deploy the pausable variant, letting the deployer halt transfers")
+	permit := fs.Bool("permit", false, "Deploy the EIP-2612 variant, supporting gasless approvals with permit")
+	votes := fs.Bool("votes", false, "Deploy the ERC20Votes variant, with vote delegation and historical voting power for governance")
+	erc777 := fs.Bool("erc777", false, "Deploy an ERC777 token, with send hooks and operators, which needs the ERC-1820 registry on the chain")
+	erc1363 := fs.Bool("erc1363", false, "Deploy the ERC1363 variant, whose transferAndCall and approveAndCall notify the receiving contract")
+	restricted := fs.Bool("restricted", false, "Deploy the restricted variant, whose owner can block addresses from sending and receiving tokens")
+	wrapped := fs.Bool("wrapped", false, "Deploy a WETH-style wrapped native token with no initial supply, minted 1:1 by depositing native currency with wrap and redeemed with unwrap")
+	taxBps := fs.Uint("tax-bps", 0, "Deploy the fee-on-transfer variant, sending this many basis points of every transfer to -treasury (at most 1000, i.e. 10%)")
+	treasuryFlag := fs.String("treasury", "", "Address receiving the transfer tax of a -tax-bps token")
+	verifyEffects := fs.Bool("verify-effects", false, "After deploying a -tax-bps token, send a test transfer to yourself and check the treasury received the expected tax")
+	operatorsFlag := fs.String("operators", "", "Comma-separated default operators of an -erc777 token, allowed to move every holder's tokens")
+	cloneOf := fs.String("clone-of", "", "Deploy an EIP-1167 clone of this InitializableERC20Token implementation and initialize it, instead of a full token")
+	upgradeable := fs.Bool("upgradeable", false, "Deploy an implementation behind an upgradeable proxy, the proxy being the token address")
+	proxyType := fs.String("proxy-type", "", "Proxy used by -upgradeable: transparent (upgraded through a ProxyAdmin) or uups (upgraded through the token) (default transparent)")
+	adminFlag := fs.String("admin", "", "Address allowed to upgrade an -upgradeable token (default: the deployer)")
+	create2 := fs.Bool("create2", false, "Deploy through a CREATE2 factory, giving the same address on every chain for the same account, -salt and token")
+	saltFlag := fs.String("salt", "", "CREATE2 salt: up to 32 bytes of 0x-prefixed hex, or any text which is hashed")
+	factoryFlag := fs.String("factory", "", "CREATE2 factory address (default: the factory at "+defaultCreate2Factory().Hex()+", deployed if missing)")
+	safeFlag := fs.String("safe", "", "Propose the deployment as a transaction of this Safe multisig, signed by the loaded key, instead of sending it")
+	safeService := fs.String("safe-service", "", "Safe Transaction Service URL receiving the -safe proposal (default: Safe's service for the chain)")
+	safeCreateCall := fs.String("safe-create-call", defaultCreateCall.Hex(), "CreateCall library the -safe delegatecalls to create the token, unused with -create2")
+	safeNonce := fs.Int64("safe-nonce", -1, "Safe nonce of the -safe proposal, to queue it behind pending ones (default: the Safe's current nonce)")
+	networksFlag := fs.String("networks", "", "Comma-separated network presets to deploy the same token to, one after another")
+	out := fs.String("out", "", "Write a JSON record of the deployment to this file after it succeeds and add it to the history shown by list-deployments, with -networks the network name is added before the extension")
+	fs.StringVar(&artifactsDir, "artifacts", "contracts/artifacts", "Directory holding the compiled artifacts and Hardhat build-info")
+	presetName := fs.String("preset", "", presetUsage())
+	metadataPath := fs.String("metadata", "", "JSON token definition with name, symbol, decimals, supply, cap and features, overridden by flags")
+	artifactPath := fs.String("artifact", "", "Deploy this compiled contract instead of a token: a Hardhat or Foundry artifact, or solc --combined-json output with :Name appended to pick the contract")
+	argsFlag := fs.String("args", "", "Constructor arguments of the -artifact contract as a JSON list or comma-separated, with arrays in brackets, encoded as its ABI declares")
+	if err := parseWithConfig(fs, args); err != nil {
+		fatal(err)
+	}
+	if *metadataPath != "" {
+		if err := applyMetadata(fs, *metadataPath); err != nil {
+			fatal(err)
+		}
+	}
+	var preset *tokenPreset
+	if *presetName != "" {
+		if *artifactPath != "" {
+			fatal("The -preset flag cannot be combined with -artifact")
+		}
+		p, err := applyPreset(fs, *presetName)
+		if err != nil {
+			fatal(err)
+		}
+		preset = p
+	}
+
+	targets, err := parseNetworkList(*networksFlag)
+	if err != nil {
+		fatal(err)
+	}
+	if len(targets) > 0 && (rpcURL != "" || networkName != "") {
+		fatal("The -networks flag cannot be combined with -rpc or -network")
+	}
+	if rpcURL == "" && networkName == "" && len(targets) == 0 {
+		fatal("One of -rpc, -network or -networks is required")
+	}
+	var supply *big.Int
+	if *artifactPath != "" {
+		// The artifact's constructor takes -args, none of the token flags.
+		fs.Visit(func(f *flag.Flag) {
+			if tokenFlags[f.Name] {
+				fatalf("The -%s flag cannot be combined with -artifact", f.Name)
+			}
+		})
+	} else if *wrapped {
+		// Wrapped tokens only exist for deposited native currency, which has
+		// 18 decimals.
+		if *argsFlag != "" {
+			fatal("The -args flag requires -artifact")
+		}
+		if *totalSupply != "" || *supplyCap != "" {
+			fatal("A -wrapped token takes no -supply or -cap, its tokens are minted by depositing native currency")
+		}
+		if *tokenDecimals != 18 {
+			fatal("A -wrapped token has 18 decimals, like the native currency it wraps")
+		}
+		if err := deployer.ValidateName(*tokenName); err != nil {
+			fatal(err)
+		}
+		if err := deployer.ValidateSymbol(*tokenSymbol); err != nil {
+			fatal(err)
+		}
+	} else {
+		if *argsFlag != "" {
+			fatal("The -args flag requires -artifact")
+		}
+		if *totalSupply == "" {
+			fatal("The -supply flag is required")
+		}
+		supply, err = deployer.ParseSupply(*totalSupply, uint8(*tokenDecimals))
+		if err != nil {
+			fatalf("Failed to parse supply: %v", err)
+		}
+		if err := deployer.ValidateParams(*tokenName, *tokenSymbol, *tokenDecimals, supply, force); err != nil {
+			fatal(err)
+		}
+		if preset != nil && preset.wholeSupply {
+			if err := checkWholeSupply(supply, uint8(*tokenDecimals), preset.name); err != nil {
+				fatal(err)
+			}
+		}
+	}
+	if countTrue(*mintable, *burnable, *pausable, *permit, *votes, *erc777, *erc1363, *restricted, *wrapped, *taxBps > 0) > 1 {
+		fatal("Only one of -mintable, -burnable, -pausable, -permit, -votes, -erc777, -erc1363, -restricted, -wrapped and -tax-bps can be given")
+	}
+	var implementation *common.Address
+	if *cloneOf != "" {
+		address, err := parseAddress(*cloneOf)
+		if err != nil {
+			fatalf("Invalid implementation address: %v", err)
+		}
+		if countTrue(*mintable, *burnable, *pausable, *permit, *votes, *erc777, *erc1363, *restricted, *wrapped, *taxBps > 0) > 0 || *supplyCap != "" {
+			fatal("The -clone-of flag cannot be combined with a variant flag or -cap, the implementation decides what the token can do")
+		}
+		if *verify || *verifySourcify {
+			fatal("Clones cannot be source verified, verify the implementation instead")
+		}
+		implementation = &address
+	}
+	var admin *common.Address
+	if *upgradeable {
+		if *proxyType == "" {
+			*proxyType = "transparent"
+		}
+		if *proxyType != "transparent" && *proxyType != "uups" {
+			fatalf("Invalid -proxy-type %q, expected transparent or uups", *proxyType)
+		}
+		if countTrue(*mintable, *burnable, *pausable, *permit, *votes, *erc777, *erc1363, *restricted, *wrapped, *taxBps > 0) > 0 || *supplyCap != "" || *cloneOf != "" {
+			fatal("The -upgradeable flag cannot be combined with a variant flag, -cap or -clone-of")
+		}
+		if *create2 {
+			fatal("The -upgradeable flag cannot be combined with -create2")
+		}
+		if *verify || *verifySourcify {
+			fatal("Upgradeable deployments cannot be source verified yet, verify the implementation separately")
+		}
+		if *adminFlag != "" {
+			address, err := parseAddress(*adminFlag)
+			if err != nil {
+				fatalf("Invalid admin address: %v", err)
+			}
+			admin = &address
+		}
+	} else if *adminFlag != "" || *proxyType != "" {
+		fatal("The -admin and -proxy-type flags require -upgradeable")
+	}
+	if *erc777 && *tokenDecimals != 18 {
+		fatal("ERC777 tokens always have 18 decimals, -decimals cannot be changed with -erc777")
+	}
+	if *operatorsFlag != "" && !*erc777 {
+		fatal("The -operators flag requires -erc777")
+	}
+	var operators []common.Address
+	if *operatorsFlag != "" {
+		for _, s := range strings.Split(*operatorsFlag, ",") {
+			s = strings.TrimSpace(s)
+			operator, err := parseAddress(s)
+			if err != nil {
+				fatalf("Invalid operator address: %v", err)
+			}
+			operators = append(operators, operator)
+		}
+	}
+	var treasury common.Address
+	if *taxBps > 0 {
+		if *taxBps > maxTaxBps {
+			fatalf("The -tax-bps must be at most %d (%d%%), got %d", maxTaxBps, maxTaxBps/100, *taxBps)
+		}
+		if *treasuryFlag == "" {
+			fatal("The -treasury flag is required with -tax-bps")
+		}
+		if treasury, err = parseAddress(*treasuryFlag); err != nil {
+			fatalf("Invalid treasury address: %v", err)
+		}
+		if treasury == (common.Address{}) {
+			fatal("The -treasury cannot be the zero address, the tax would be burned")
+		}
+	} else if *treasuryFlag != "" {
+		fatal("The -treasury flag requires -tax-bps")
+	}
+	if *verifyEffects && *taxBps == 0 {
+		fatal("The -verify-effects flag requires -tax-bps")
+	}
+	if *supplyCap != "" && !*mintable {
+		fatal("The -cap flag requires -mintable")
+	}
+	var override stateOverride
+	if *overrideFlag != "" {
+		if !*dryRun {
+			fatal("The -state-override flag requires -dryrun")
+		}
+		if override, err = parseStateOverride(*overrideFlag); err != nil {
+			fatal(err)
+		}
+	}
+	var salt [32]byte
+	factory := defaultCreate2Factory()
+	if *create2 {
+		if salt, err = parseSalt(*saltFlag); err != nil {
+			fatal(err)
+		}
+		if *factoryFlag != "" {
+			if factory, err = parseAddress(*factoryFlag); err != nil {
+				fatalf("Invalid factory address: %v", err)
+			}
+		}
+	}
+	if *attest && (useLedger || signerURL != "") {
+		fatal("The -attest flag requires a private key, -ledger and -signer-url are not supported")
+	}
+	var safe *safeDeployment
+	if *safeFlag != "" {
+		address, err := parseAddress(*safeFlag)
+		if err != nil {
+			fatalf("Invalid Safe address: %v", err)
+		}
+		createCall, err := parseAddress(*safeCreateCall)
+		if err != nil {
+			fatalf("Invalid CreateCall address: %v", err)
+		}
+		if len(targets) > 0 || *dryRun || *cloneOf != "" || *upgradeable || *verifyEffects {
+			fatal("The -safe flag cannot be combined with -networks, -dryrun, -clone-of, -upgradeable or -verify-effects")
+		}
+		if *verify || *verifySourcify || *attest || *out != "" {
+			fatal("A -safe proposal deploys nothing yet, -verify, -verify-sourcify, -attest and -out apply once it is executed")
+		}
+		safe = &safeDeployment{safe: address, service: *safeService, createCall: createCall, nonce: *safeNonce}
+	} else if *safeService != "" || *safeNonce >= 0 {
+		fatal("The -safe-service and -safe-nonce flags require -safe")
+	}
+	if *verify && etherscanAPIKey == "" {
+		fatal("The -etherscan-apikey flag is required with -verify")
+	}
+
+	variant := standardToken
+	switch {
+	case *mintable:
+		variant = mintableToken
+	case *burnable:
+		variant = burnableToken
+	case *pausable:
+		variant = pausableToken
+	case *permit:
+		variant = permitToken
+	case *votes:
+		variant = votesToken
+	case *erc1363:
+		variant = erc1363Token
+	case *restricted:
+		variant = restrictedToken
+	case *wrapped:
+		variant = wrappedToken
+	case *taxBps > 0:
+		variant = taxToken
+	}
+	if implementation != nil {
+		variant = initializableToken
+	}
+	proxy := ""
+	if *upgradeable {
+		proxy = *proxyType
+		variant = initializableToken
+		if proxy == "uups" {
+			variant = uupsToken
+		}
+	}
+	ctorArgs := []interface{}{*tokenName, *tokenSymbol, uint8(*tokenDecimals), supply}
+	if *erc777 {
+		variant = erc777Token
+		// The ERC777 constructor has no decimals but takes the operators.
+		if operators == nil {
+			operators = []common.Address{}
+		}
+		ctorArgs = []interface{}{*tokenName, *tokenSymbol, supply, operators}
+	}
+	var cap *big.Int
+	if *supplyCap != "" {
+		cap, err = deployer.ParseSupply(*supplyCap, uint8(*tokenDecimals))
+		if err != nil {
+			fatalf("Failed to parse cap: %v", err)
+		}
+		if cap.Sign() == 0 {
+			fatal("The -cap must be greater than zero")
+		}
+		if supply.Cmp(cap) > 0 {
+			fatalf("The initial supply %s exceeds the cap %s", *totalSupply, *supplyCap)
+		}
+		variant = cappedToken
+		ctorArgs = append(ctorArgs, cap)
+	}
+	if variant == taxToken {
+		ctorArgs = append(ctorArgs, uint16(*taxBps), treasury)
+	}
+	if variant == wrappedToken {
+		ctorArgs = []interface{}{*tokenName, *tokenSymbol}
+	}
+	if *artifactPath != "" {
+		if variant, err = loadContractArtifact(*artifactPath); err != nil {
+			fatal(err)
+		}
+		if ctorArgs, err = parseConstructorArgs(variant, *argsFlag); err != nil {
+			fatal(err)
+		}
+	}
+
+	plan := &deployPlan{
+		variant:        variant,
+		ctorArgs:       ctorArgs,
+		name:           *tokenName,
+		symbol:         *tokenSymbol,
+		decimals:       uint8(*tokenDecimals),
+		supply:         supply,
+		cap:            cap,
+		create2:        *create2,
+		salt:           salt,
+		factory:        factory,
+		cloneOf:        implementation,
+		proxyType:      proxy,
+		proxyAdmin:     admin,
+		dryRun:         *dryRun,
+		stateOverride:  override,
+		jsonOutput:     *jsonOutput,
+		verify:         *verify,
+		verifySourcify: *verifySourcify,
+		out:            *out,
+		attest:         *attest,
+		artifact:       *artifactPath,
+		taxBps:         uint16(*taxBps),
+		treasury:       treasury,
+		verifyTax:      *verifyEffects,
+	}
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	if safe != nil {
+		if err := proposeSafeDeployment(plan, account, *safe); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	if len(targets) > 0 {
+		if !deployToNetworks(plan, account, targets) {
+			account.Close()
+			exit(1)
+		}
+		return
+	}
+
+	result, err := deployToken(plan, account)
+	if *jsonOutput && result != nil {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			fatalf("Failed to write JSON output: %v", err)
+		}
+	}
+	if err != nil {
+		fatal(err)
+	}
+}
+
+// deployPlan holds everything about a deployment that does not depend on
+// the network it is sent to.
+type deployPlan struct {
+	variant        tokenVariant
+	ctorArgs       []interface{}
+	name           string
+	symbol         string
+	decimals       uint8
+	supply         *big.Int
+	cap            *big.Int
+	create2        bool
+	salt           [32]byte
+	factory        common.Address
+	cloneOf        *common.Address
+	proxyType      string          // "transparent" or "uups" for -upgradeable
+	proxyAdmin     *common.Address // nil for the deployer
+	dryRun         bool
+	stateOverride  stateOverride
+	jsonOutput     bool
+	verify         bool
+	verifySourcify bool
+	out            string
+	attest         bool
+	artifact       string // -artifact path, variant is then the artifact's contract
+	taxBps         uint16
+	treasury       common.Address
+	verifyTax      bool // send a test transfer to check the tax of a -tax-bps token
+}
+
+// tokenFlags are the deploy flags describing the token, which a custom
+// -artifact deployment does not take.
+var tokenFlags = map[string]bool{
+	"name": true, "symbol": true, "decimals": true, "supply": true, "metadata": true, "preset": true,
+	"mintable": true, "burnable": true, "pausable": true, "permit": true, "votes": true,
+	"erc777": true, "erc1363": true, "restricted": true, "wrapped": true, "tax-bps": true, "treasury": true, "verify-effects": true, "operators": true, "cap": true, "clone-of": true,
+	"upgradeable": true, "proxy-type": true, "admin": true, "attest": true,
+}
+
+// deployToken deploys the planned token to the network selected by -rpc or
+// -network, reporting progress as it goes. For a dry run the result is nil.
+func deployToken(plan *deployPlan, account *signer) (*deployResult, error) {
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the Ethereum network: %w", err)
+	}
+	defer client.Close()
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transactor: %w", err)
+	}
+
+	progress := progressWriter(plan.jsonOutput)
+	variant := plan.variant
+	if variant == erc777Token {
+		if err := checkERC1820Registry(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+
+	// An upgradeable deployment first creates the implementation with the
+	// current nonce, then the proxy, which is the token, with the next one.
+	var deployData []byte
+	var implementation common.Address
+	proxyVariant, proxyArgs := variant, plan.ctorArgs
+	switch {
+	case plan.cloneOf != nil:
+		if err := checkImplementation(ctx, client, *plan.cloneOf); err != nil {
+			return nil, err
+		}
+		deployData = cloneInitCode(*plan.cloneOf)
+	case plan.proxyType != "":
+		implementation = predictContractAddress(auth)
+		admin := auth.From
+		if plan.proxyAdmin != nil {
+			admin = *plan.proxyAdmin
+		}
+		if proxyVariant, proxyArgs, err = proxyDeployment(plan, implementation, admin); err != nil {
+			return nil, err
+		}
+		if deployData, err = proxyVariant.DeployData(proxyArgs...); err != nil {
+			return nil, fmt.Errorf("failed to encode deployment data: %w", err)
+		}
+	default:
+		if deployData, err = variant.DeployData(plan.ctorArgs...); err != nil {
+			return nil, fmt.Errorf("failed to encode deployment data: %w", err)
+		}
+	}
+
+	// A plain deployment is a contract creation, a CREATE2 one a factory call.
+	msg := ethereum.CallMsg{From: auth.From, Data: deployData}
+	predicted := predictContractAddress(auth)
+	if plan.proxyType != "" {
+		predicted = crypto.CreateAddress(auth.From, auth.Nonce.Uint64()+1)
+	}
+	if plan.create2 {
+		msg.To = &plan.factory
+		msg.Data = create2Calldata(plan.salt, deployData)
+		predicted = predictCreate2Address(plan.factory, auth.From, plan.salt, deployData)
+		if code, err := client.CodeAt(ctx, predicted, nil); err != nil {
+			return nil, fmt.Errorf("failed to check the predicted address: %w", err)
+		} else if len(code) > 0 {
+			return nil, fmt.Errorf("a contract already exists at %s, use a different -salt", predicted.Hex())
+		}
+	}
+	fmt.Fprintf(progress, "Predicted contract address: %s\n", predicted.Hex())
+	// The proxy constructor calls into the implementation, so its gas can
+	// only be estimated once the implementation exists.
+	if plan.proxyType == "" {
+		auth.GasLimit = estimateDeployGas(ctx, client, msg)
+	}
+
+	buildTx := func() (common.Address, *types.Transaction, error) {
+		if plan.create2 {
+			tx, err := create2Transact(auth, client, plan.factory, msg.Data)
+			return predicted, tx, err
+		}
+		if plan.cloneOf != nil {
+			address, tx, _, err := bind.DeployContract(auth, abi.ABI{}, deployData, client)
+			return address, tx, err
+		}
+		return proxyVariant.Deploy(auth, client, proxyArgs...)
+	}
+
+	if plan.dryRun {
+		if plan.create2 {
+			if code, err := client.CodeAt(ctx, plan.factory, nil); err == nil && len(code) == 0 {
+				// Simulate the token on its own, the factory would be deployed first.
+				fmt.Printf("The CREATE2 factory %s is not deployed yet and would be deployed first.\n", plan.factory.Hex())
+				msg = ethereum.CallMsg{From: auth.From, Data: deployData}
+				auth.GasLimit = estimateDeployGas(ctx, client, msg)
+			}
+		}
+		if plan.proxyType != "" {
+			// Simulate the implementation, the proxy would be deployed next.
+			fmt.Printf("The %s proxy would be deployed next, at %s.\n", plan.proxyType, predicted.Hex())
+			if msg.Data, err = variant.DeployData(); err != nil {
+				return nil, fmt.Errorf("failed to encode implementation deployment: %w", err)
+			}
+			auth.GasLimit = estimateDeployGas(ctx, client, msg)
+			buildTx = func() (common.Address, *types.Transaction, error) {
+				return variant.Deploy(auth, client)
+			}
+		}
+		return nil, dryRunDeploy(ctx, client, auth, msg, plan.stateOverride, buildTx)
+	}
+
+	if err := checkGasFunds(ctx, client, auth); err != nil {
+		return nil, err
+	}
+	action := "deploy " + plan.symbol
+	if plan.artifact != "" {
+		action = "deploy " + variant.Name
+	}
+	if err := confirmBroadcast(ctx, progress, client, action); err != nil {
+		return nil, err
+	}
+
+	if plan.create2 {
+		deployed, err := ensureCreate2Factory(ctx, client, auth, plan.factory)
+		if err != nil {
+			return nil, err
+		}
+		if deployed {
+			msg.From = auth.From
+			auth.GasLimit = estimateDeployGas(ctx, client, msg)
+		}
+	}
+
+	// Receipts of the other transactions of the deployment, such as the
+	// implementation of a proxy, whose gas counts towards the deployment.
+	var extraReceipts []*types.Receipt
+	if plan.proxyType != "" {
+		implReceipt, err := deployImplementation(ctx, client, auth, plan, implementation, progress)
+		if err != nil {
+			return nil, err
+		}
+		extraReceipts = append(extraReceipts, implReceipt)
+		msg.From = auth.From
+		auth.GasLimit = estimateDeployGas(ctx, client, msg)
+	}
+
+	address, tx, err := buildTx()
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy contract: %w", err)
+	}
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return nil, fmt.Errorf("failed to deploy contract: %w", err)
+	}
+
+	if address != predicted {
+		slog.Warn("Deployed address differs from the prediction, another transaction may have used the nonce",
+			"predicted", predicted.Hex(), "actual", address.Hex())
+	}
+
+	fmt.Fprintf(progress, "Token deployment initiated!\n")
+	fmt.Fprintf(progress, "Contract address: %s\n", address.Hex())
+	fmt.Fprintf(progress, "Transaction hash: %s\n", tx.Hash().Hex())
+	fmt.Fprintf(progress, "Waiting for transaction to be mined...\n")
+
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for mining: %w", err)
+	}
+
+	if plan.create2 && receipt.Status == 1 {
+		if code, err := client.CodeAt(ctx, address, receipt.BlockNumber); err != nil {
+			return nil, fmt.Errorf("failed to check the deployed contract: %w", err)
+		} else if len(code) == 0 {
+			return nil, fmt.Errorf("the factory call succeeded but no contract exists at the predicted address %s", address.Hex())
+		}
+	}
+
+	if plan.cloneOf != nil && receipt.Status == 1 {
+		initReceipt, err := initializeClone(ctx, client, auth, plan, address, progress)
+		if err != nil {
+			return nil, err
+		}
+		// The clone only holds its name, supply and balances once initialized,
+		// so the result is read after the initialize call.
+		extraReceipts = append(extraReceipts, receipt)
+		combined := *initReceipt
+		combined.TxHash = receipt.TxHash
+		receipt = &combined
+		fmt.Fprintf(progress, "Clone of %s initialized.\n", plan.cloneOf.Hex())
+	}
+
+	result, err := readDeployResult(ctx, client, tx, receipt, address, auth.From)
+	if err != nil {
+		return nil, err
+	}
+	result.Wrapped = plan.variant == wrappedToken
+	for _, extra := range extraReceipts {
+		result.GasUsed += extra.GasUsed
+	}
+	if extraReceipts != nil {
+		result.GasCost = formatAmount(receiptCost(append(extraReceipts, receipt)...), 18)
+	}
+	if plan.attest && receipt.Status == 1 {
+		if result.Attestation, err = attestDeployment(ctx, client, account, plan, address, receipt); err != nil {
+			return result, fmt.Errorf("failed to sign attestation: %w", err)
+		}
+	}
+
+	if plan.proxyType != "" && receipt.Status == 1 {
+		result.Implementation = implementation.Hex()
+		result.ProxyType = plan.proxyType
+		if admin, err := readProxyAdmin(ctx, client, address); err == nil && admin != (common.Address{}) {
+			result.ProxyAdmin = admin.Hex()
+		}
+	}
+
+	// In JSON mode the caller prints the result.
+	if !plan.jsonOutput {
+		printDeploySummary(result)
+	}
+	if receipt.Status != 1 {
+		return result, fmt.Errorf("%w: %s", deployer.ErrDeployReverted, result.RevertReason)
+	}
+
+	if plan.verifyTax {
+		if err := verifyTransferTax(ctx, client, auth, plan, address, progress); err != nil {
+			return result, err
+		}
+	}
+
+	if plan.out != "" && receipt.Status == 1 {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			return result, fmt.Errorf("failed to get chain ID: %w", err)
+		}
+		record := newDeploymentRecord(plan, chainID, auth.From, address, receipt)
+		record.GasUsed = result.GasUsed
+		record.Attestation = result.Attestation
+		if result.Implementation != "" {
+			record.Proxy = &proxyRecord{Type: result.ProxyType, Implementation: result.Implementation, Admin: result.ProxyAdmin}
+		}
+		if err := writeDeploymentRecord(plan.out, record); err != nil {
+			return result, err
+		}
+		fmt.Fprintf(progress, "Deployment record written to %s\n", plan.out)
+		appendHistory(record, plan.out)
+	}
+
+	if plan.verifySourcify && receipt.Status == 1 {
+		if err := verifyOnSourcify(ctx, progress, client, variant, address); err != nil {
+			return result, fmt.Errorf("sourcify verification failed: %w", err)
+		}
+	}
+
+	if plan.verify && receipt.Status == 1 {
+		constructorArgs, err := variant.PackConstructor(plan.ctorArgs...)
+		if err != nil {
+			return result, fmt.Errorf("failed to encode constructor arguments: %w", err)
+		}
+		if err := verifyOnEtherscan(ctx, progress, client, variant, address, constructorArgs); err != nil {
+			return result, fmt.Errorf("etherscan verification failed: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// readDeployResult reads the outcome of the deployment of a token to
+// address in tx, and for a successful one the token's details.
+func readDeployResult(ctx context.Context, client *ethclient.Client, tx *types.Transaction, receipt *types.Receipt, address, deployer common.Address) (*deployResult, error) {
+	result := &deployResult{
+		SchemaVersion:   deployResultSchemaVersion,
+		ContractAddress: address.Hex(),
+		TransactionHash: tx.Hash().Hex(),
+		Status:          receipt.Status,
+		GasUsed:         receipt.GasUsed,
+		Deployer:        deployer.Hex(),
+	}
+	if receipt.EffectiveGasPrice != nil {
+		result.GasCost = formatAmount(receiptCost(receipt), 18)
+	}
+	if receipt.Status != 1 {
+		result.RevertReason = receiptRevertReason(ctx, client, tx, receipt)
+	}
+	if receipt.Status == 1 {
+		// Every variant extends the standard token, so its binding reads them all.
+		instance, err := NewERC20Token(address, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind deployed contract: %w", err)
+		}
+		if name, err := readTokenString(&bind.CallOpts{Context: ctx}, client, address, "name"); err == nil {
+			result.Name = name
+		}
+		if symbol, err := readTokenString(&bind.CallOpts{Context: ctx}, client, address, "symbol"); err == nil {
+			result.Symbol = symbol
+		}
+		if decimals, err := instance.Decimals(&bind.CallOpts{Context: ctx}); err == nil {
+			result.Decimals = &decimals
+		}
+		if result.Decimals != nil {
+			// Read at the deployment block so later transfers don't skew the figures.
+			opts := &bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}
+			if supply, err := instance.TotalSupply(opts); err == nil {
+				result.TotalSupply = formatAmount(supply, *result.Decimals)
+			}
+			if balance, err := instance.BalanceOf(opts, deployer); err == nil {
+				result.DeployerBalance = formatAmount(balance, *result.Decimals)
+			}
+		}
+		// Only the ownable variants have owner(), for the others the call fails.
+		if ownable, err := NewOwnable(address, client); err == nil {
+			if owner, err := ownable.Owner(&bind.CallOpts{Context: ctx}); err == nil {
+				result.Owner = owner.Hex()
+			}
+		}
+		// Likewise only the capped variant has cap().
+		capped, err := NewCappedERC20Token(address, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind deployed contract: %w", err)
+		}
+		if cap, err := capped.Cap(&bind.CallOpts{Context: ctx}); err == nil && result.Decimals != nil {
+			result.Cap = formatAmount(cap, *result.Decimals)
+		}
+		// Only the restricted variant has isBlocked().
+		restricted, err := NewRestrictedERC20Token(address, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind deployed contract: %w", err)
+		}
+		if _, err := restricted.IsBlocked(&bind.CallOpts{Context: ctx}, common.Address{}); err == nil {
+			result.Restricted = true
+		}
+		// Only the tax variant has a transfer tax and treasury.
+		taxed, err := NewTaxERC20Token(address, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind deployed contract: %w", err)
+		}
+		if bps, err := taxed.TaxBps(&bind.CallOpts{Context: ctx}); err == nil {
+			result.TaxBps = &bps
+			if treasury, err := taxed.Treasury(&bind.CallOpts{Context: ctx}); err == nil {
+				result.Treasury = treasury.Hex()
+			}
+		}
+		// And only ERC777 tokens have a granularity and default operators.
+		erc777, err := NewERC777Token(address, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to bind deployed contract: %w", err)
+		}
+		if granularity, err := erc777.Granularity(&bind.CallOpts{Context: ctx}); err == nil && result.Decimals != nil {
+			result.Granularity = formatAmount(granularity, *result.Decimals)
+			if operators, err := erc777.DefaultOperators(&bind.CallOpts{Context: ctx}); err == nil {
+				result.Operators = []string{}
+				for _, operator := range operators {
+					result.Operators = append(result.Operators, operator.Hex())
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// receiptCost returns the fees paid for the transactions of receipts, in wei.
+func receiptCost(receipts ...*types.Receipt) *big.Int {
+	cost := new(big.Int)
+	for _, receipt := range receipts {
+		if receipt.EffectiveGasPrice != nil {
+			cost.Add(cost, new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed)))
+		}
+	}
+	return cost
+}
+
+// printDeploySummary prints the human-readable outcome of a successful
+// deployment; a reverted one is reported through the returned error.
+func printDeploySummary(result *deployResult) {
+	if result.Status != 1 {
+		return
+	}
+	fmt.Printf("\nDeployment successful!\n")
+	fmt.Printf("Gas used: %d\n", result.GasUsed)
+	if result.Name != "" {
+		fmt.Printf("Token name: %s\n", result.Name)
+	}
+	if result.Symbol != "" {
+		fmt.Printf("Token symbol: %s\n", result.Symbol)
+	}
+	if result.Decimals != nil {
+		fmt.Printf("Token decimals: %d\n", *result.Decimals)
+	}
+	if result.TotalSupply != "" {
+		fmt.Printf("Total supply: %s\n", result.TotalSupply)
+	}
+	if result.Cap != "" {
+		fmt.Printf("Supply cap: %s\n", result.Cap)
+	}
+	if result.TaxBps != nil {
+		fmt.Printf("Transfer tax: %d bps (%s%%) to %s\n", *result.TaxBps, formatAmount(big.NewInt(int64(*result.TaxBps)), 2), result.Treasury)
+	}
+	if result.Granularity != "" {
+		fmt.Printf("Granularity: %s\n", result.Granularity)
+	}
+	if result.Operators != nil {
+		if len(result.Operators) == 0 {
+			fmt.Printf("Default operators: none\n")
+		} else {
+			fmt.Printf("Default operators: %s\n", strings.Join(result.Operators, ", "))
+		}
+	}
+	if result.DeployerBalance != "" {
+		fmt.Printf("Deployer balance: %s\n", result.DeployerBalance)
+	}
+	if result.Owner != "" {
+		fmt.Printf("Owner: %s\n", result.Owner)
+	}
+	if result.Wrapped {
+		fmt.Printf("Wrapped: every token is backed 1:1 by native currency held by the contract, minted by wrap and redeemed by unwrap.\n")
+		fmt.Printf("  The supply starts at zero; a bug in the contract would put the deposited native currency at risk.\n")
+	}
+	if result.Restricted {
+		fmt.Printf("Restricted: the owner can block any address with block-address, freezing its balance.\n")
+		fmt.Printf("  Holders have to trust the owner key, losing or leaking it leaves the denylist frozen or abused;\n")
+		fmt.Printf("  consider a multisig owner, or renounce-ownership once blocking is no longer needed.\n")
+	}
+	if result.Implementation != "" {
+		fmt.Printf("Implementation: %s\n", result.Implementation)
+		fmt.Printf("Proxy (%s): %s, use this address for the token, it keeps its state across upgrades\n", result.ProxyType, result.ContractAddress)
+		if result.ProxyAdmin != "" {
+			fmt.Printf("ProxyAdmin contract: %s\n", result.ProxyAdmin)
+		}
+	}
+	if result.Attestation != nil {
+		fmt.Printf("Attestation signature: %s\n", result.Attestation.Signature)
+	}
+}
+
+// countTrue returns how many of the given flags are set.
+func countTrue(flags ...bool) int {
+	n := 0
+	for _, f := range flags {
+		if f {
+			n++
+		}
+	}
+	return n
+}
+
+// progressWriter returns where progress messages go. In JSON mode stdout is
+// reserved for the result object, so they go to stderr.
+func progressWriter(jsonOutput bool) io.Writer {
+	if jsonOutput {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// dryRunDeploy signs the deployment without sending it and simulates the
+// contract creation with eth_call, reporting the estimated gas and nonce.
+// A non-nil override is applied to the state the call runs against.
+func dryRunDeploy(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, msg ethereum.CallMsg, override stateOverride, buildTx func() (common.Address, *types.Transaction, error)) error {
+	_, tx, err := buildTx()
+	if err != nil {
+		return fmt.Errorf("failed to build deployment transaction: %w", err)
+	}
+
+	msg.Gas = auth.GasLimit
+	if override != nil {
+		_, err = callWithOverride(ctx, client, msg, override)
+	} else {
+		_, err = client.CallContract(ctx, msg, nil)
+	}
+	if err != nil {
+		if reason, ok := revertReason(err); ok {
+			return fmt.Errorf("deployment would revert: %s", reason)
+		}
+		return fmt.Errorf("deployment simulation failed: %w", err)
+	}
+
+	fmt.Printf("Dry run successful, nothing was broadcast.\n")
+	fmt.Printf("Estimated gas: %d\n", auth.GasLimit)
+	fmt.Printf("Nonce: %d\n", tx.Nonce())
+	return nil
+}
+
+// predictContractAddress returns the address a contract created by the next
+// transaction from auth will get, derived from the sender and nonce.
+func predictContractAddress(auth *bind.TransactOpts) common.Address {
+	return crypto.CreateAddress(auth.From, auth.Nonce.Uint64())
+}
+
+// estimateDeployGas estimates the gas needed by the deployment msg and adds
+// the -gasbuffer percentage. If estimation fails, the -gas value is returned
+// instead.
+func estimateDeployGas(ctx context.Context, client *ethclient.Client, msg ethereum.CallMsg) uint64 {
+	estimate, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		slog.Warn("Gas estimation failed, falling back to -gas", "gas", gasLimit, "err", err)
+		return gasLimit
+	}
+	return estimate + estimate*gasBuffer/100
+}
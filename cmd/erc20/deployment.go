@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// deploymentRecordVersion is bumped whenever the fields of deploymentRecord
+// change incompatibly. Adding fields is not incompatible.
+const deploymentRecordVersion = 1
+
+// deploymentRecord is the file written by -out after a successful
+// deployment. Amounts are in base units, as decimal strings.
+type deploymentRecord struct {
+	Version         int             `json:"version"`
+	Network         string          `json:"network,omitempty"`
+	ChainID         uint64          `json:"chainId"`
+	ContractAddress string          `json:"contractAddress"`
+	TransactionHash string          `json:"transactionHash"`
+	Deployer        string          `json:"deployer"`
+	BlockNumber     uint64          `json:"blockNumber"`
+	GasUsed         uint64          `json:"gasUsed"`
+	Token           deploymentToken `json:"token"`
+	CloneOf         string          `json:"cloneOf,omitempty"`
+	Create2         *create2Record  `json:"create2,omitempty"`
+	Proxy           *proxyRecord    `json:"proxy,omitempty"`
+	Attestation     *attestation    `json:"attestation,omitempty"`
+}
+
+// deploymentToken holds the constructor parameters of a deployed token.
+type deploymentToken struct {
+	Contract      string `json:"contract"`
+	Name          string `json:"name"`
+	Symbol        string `json:"symbol"`
+	Decimals      uint8  `json:"decimals"`
+	InitialSupply string `json:"initialSupply"`
+	Cap           string `json:"cap,omitempty"`
+	TaxBps        uint16 `json:"taxBps,omitempty"`
+	Treasury      string `json:"treasury,omitempty"`
+	// Artifact and ConstructorArgs are only set for an -artifact deployment,
+	// whose contract need not be a token.
+	Artifact        string `json:"artifact,omitempty"`
+	ConstructorArgs string `json:"constructorArgs,omitempty"` // ABI-encoded, 0x-prefixed
+}
+
+// create2Record holds the factory and salt of a -create2 deployment.
+type create2Record struct {
+	Factory string `json:"factory"`
+	Salt    string `json:"salt"`
+}
+
+// proxyRecord describes the proxy of an -upgradeable deployment, whose
+// address is the contract address of the record.
+type proxyRecord struct {
+	Type           string `json:"type"`
+	Implementation string `json:"implementation"`
+	Admin          string `json:"admin,omitempty"`
+}
+
+// newDeploymentRecord describes the deployment of plan in receipt.
+func newDeploymentRecord(plan *deployPlan, chainID *big.Int, deployer, address common.Address, receipt *types.Receipt) *deploymentRecord {
+	record := &deploymentRecord{
+		Version:         deploymentRecordVersion,
+		Network:         networkName,
+		ChainID:         chainID.Uint64(),
+		ContractAddress: address.Hex(),
+		TransactionHash: receipt.TxHash.Hex(),
+		Deployer:        deployer.Hex(),
+		BlockNumber:     receipt.BlockNumber.Uint64(),
+		GasUsed:         receipt.GasUsed,
+		Token: deploymentToken{
+			Contract: plan.variant.Name,
+			Name:     plan.name,
+			Symbol:   plan.symbol,
+			Decimals: plan.decimals,
+		},
+	}
+	if plan.supply != nil {
+		record.Token.InitialSupply = plan.supply.String()
+	}
+	if plan.artifact != "" {
+		record.Token.Decimals = 0
+		record.Token.Artifact = plan.artifact
+		if packed, err := plan.variant.PackConstructor(plan.ctorArgs...); err == nil {
+			record.Token.ConstructorArgs = hexutil.Encode(packed)
+		}
+	}
+	if plan.cap != nil {
+		record.Token.Cap = plan.cap.String()
+	}
+	if plan.taxBps > 0 {
+		record.Token.TaxBps = plan.taxBps
+		record.Token.Treasury = plan.treasury.Hex()
+	}
+	if plan.cloneOf != nil {
+		record.CloneOf = plan.cloneOf.Hex()
+	}
+	if plan.create2 {
+		record.Create2 = &create2Record{Factory: plan.factory.Hex(), Salt: hexutil.Encode(plan.salt[:])}
+	}
+	return record
+}
+
+// writeDeploymentRecord writes record as indented JSON to path.
+func writeDeploymentRecord(path string, record *deploymentRecord) error {
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write deployment record: %w", err)
+	}
+	return nil
+}
+
+// networkOutPath returns the -out path for one network of a -networks
+// deployment by adding the network name before the extension, so that
+// deployment.json becomes deployment.sepolia.json.
+func networkOutPath(path, network string) string {
+	ext := filepath.Ext(path)
+	return strings.TrimSuffix(path, ext) + "." + network + ext
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// erc1363InterfaceID is the ERC-165 interface ID of ERC1363.
+var erc1363InterfaceID = [4]byte{0xb0, 0x20, 0x2a, 0x11}
+
+// DeployERC1363Token deploys a new ERC1363Token contract, whose transfers
+// and approvals can call into the receiving contract, and binds an instance
+// of it.
+func DeployERC1363Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *ERC1363Token, error) {
+	address, tx, err := erc1363Token.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewERC1363Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runTransferAndCall(args []string) {
+	fs := newFlagSet("transfer-and-call")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -erc1363")
+	to := fs.String("to", "", "Receiving contract, notified through onTransferReceived")
+	amount := fs.String("amount", "", "Amount of tokens to transfer (in whole units)")
+	dataFlag := fs.String("data", "", "0x-prefixed hex data passed to the receiver (optional)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *to == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -to, -amount")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid recipient address: %v", err)
+	}
+	var data []byte
+	if *dataFlag != "" {
+		var err error
+		if data, err = hexutil.Decode(*dataFlag); err != nil {
+			fatalf("Invalid -data, expected 0x-prefixed hex: %v", err)
+		}
+	}
+	receiver := common.HexToAddress(*to)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC1363Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	if ok, err := instance.SupportsInterface(&bind.CallOpts{Context: ctx}, erc1363InterfaceID); err != nil || !ok {
+		fatalf("Token %s does not support ERC1363, is it deployed with -erc1363?", common.HexToAddress(*contract).Hex())
+	}
+
+	// Only contracts implementing onTransferReceived can accept the tokens,
+	// the token reverts the transfer to anything else.
+	code, err := client.CodeAt(ctx, receiver, nil)
+	if err != nil {
+		fatalf("Failed to get the code of the receiver: %v", err)
+	}
+	if len(code) == 0 {
+		slog.Warn("The receiver has no code, so the token will reject the transfer", "to", receiver.Hex())
+	}
+
+	decimals, err := tokenDecimals(ctx, client, common.HexToAddress(*contract))
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "transfer "+*amount+" tokens and call "+receiver.Hex()); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.TransferAndCall0(auth, receiver, value, data)
+	if err != nil {
+		fatal(txError("transfer and call", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Transfer and call")
+	if err != nil {
+		fatalf("Transfer and call failed: %v", err)
+	}
+
+	// The token checks the value returned by the callback and reverts the
+	// whole transfer unless the receiver accepted it, so a successful
+	// transaction means the callback succeeded.
+	if receipt.Status == 1 {
+		fmt.Printf("Receiver callback: accepted\n")
+	} else {
+		fmt.Printf("Receiver callback: failed or rejected the transfer, which was reverted\n")
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// erc1820Registry is the address of the ERC-1820 registry, the same on every
+// chain it was deployed to with the keyless deployment of the standard.
+var erc1820Registry = common.HexToAddress("0x1820a4B7618BdE71Dce8cdc73aAB6C95905faD24")
+
+// DeployERC777Token deploys a new ERC777Token contract, with the given
+// default operators, and binds an instance of it.
+func DeployERC777Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, supply *big.Int, defaultOperators []common.Address) (common.Address, *types.Transaction, *ERC777Token, error) {
+	address, tx, err := erc777Token.Deploy(auth, backend, name, symbol, supply, defaultOperators)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewERC777Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+// checkERC1820Registry makes sure the chain has the ERC-1820 registry, which
+// the ERC777 constructor registers the token with, and reminds the user that
+// ERC777 hooks call into senders and recipients.
+func checkERC1820Registry(ctx context.Context, client *ethclient.Client) error {
+	code, err := client.CodeAt(ctx, erc1820Registry, nil)
+	if err != nil {
+		return fmt.Errorf("failed to check the ERC-1820 registry: %w", err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("the ERC-1820 registry is not deployed at %s on this chain, ERC777 tokens cannot be deployed without it", erc1820Registry.Hex())
+	}
+	slog.Warn("ERC777 tokens depend on the ERC-1820 registry and call the hooks senders and recipients register there, which integrations must guard against reentrancy")
+	return nil
+}
+
+func runAuthorizeOperator(args []string) {
+	fs := newFlagSet("authorize-operator")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -erc777")
+	operator := fs.String("operator", "", "Address allowed to send and burn the signer's tokens")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *operator == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -operator")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*operator); err != nil {
+		fatalf("Invalid operator address: %v", err)
+	}
+	operatorAddress := common.HexToAddress(*operator)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC777Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	authorized, err := instance.IsOperatorFor(&bind.CallOpts{Context: ctx}, operatorAddress, account.address)
+	if err != nil {
+		fatalf("Failed to query operators, is the token deployed with -erc777? %v", err)
+	}
+	if authorized {
+		fmt.Printf("%s is already an operator for %s.\n", operatorAddress.Hex(), account.address.Hex())
+		return
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "authorize "+operatorAddress.Hex()+" as operator"); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.AuthorizeOperator(auth, operatorAddress)
+	if err != nil {
+		fatal(txError("authorize operator", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Authorization")
+	if err != nil {
+		fatalf("Authorization failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		opts := &bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}
+		if authorized, err := instance.IsOperatorFor(opts, operatorAddress, account.address); err == nil && authorized {
+			fmt.Printf("%s can now send and burn the tokens of %s.\n", operatorAddress.Hex(), account.address.Hex())
+		}
+	}
+}
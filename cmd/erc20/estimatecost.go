@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+func runEstimateCost(args []string) {
+	fs := newFlagSet("estimate-cost")
+	addRPCFlag(fs)
+	variantName := fs.String("variant", "standard", "Variant to quote: standard, mintable, burnable, pausable, capped, permit, votes, erc777, erc1363, tax, restricted or wrapped")
+	tokenName := fs.String("name", "Token", "Name of the token")
+	tokenSymbol := fs.String("symbol", "TKN", "Symbol of the token")
+	tokenDecimals := fs.Uint("decimals", 18, "Number of decimals for the token")
+	totalSupply := fs.String("supply", "1000000", "Total supply of tokens (in whole units)")
+	supplyCap := fs.String("cap", "", "Maximum total supply for the capped variant (default: the supply)")
+	from := fs.String("from", "", "Deployer address to estimate for (optional)")
+	fs.StringVar(&artifactsDir, "artifacts", "contracts/artifacts", "Directory holding the compiled artifacts")
+	priceAPI := fs.String("price-api", "", "URL returning the USD price of the native token as JSON, e.g. a CoinGecko simple/price URL (optional)")
+	priceField := fs.String("price-field", "", "Dot-separated path of the price in the -price-api response, e.g. ethereum.usd (default: its only number)")
+	fs.Parse(args)
+
+	if rpcURL == "" && networkName == "" {
+		fatal("One of -rpc or -network is required")
+	}
+	variant, err := lookupVariant(*variantName)
+	if err != nil {
+		fatal(err)
+	}
+	if *tokenDecimals > 255 {
+		fatal("The -decimals must be at most 255")
+	}
+	supply, err := deployer.ParseSupply(*totalSupply, uint8(*tokenDecimals))
+	if err != nil {
+		fatalf("Failed to parse supply: %v", err)
+	}
+	ctorArgs := []interface{}{*tokenName, *tokenSymbol, uint8(*tokenDecimals), supply}
+	if variant == erc777Token {
+		ctorArgs = []interface{}{*tokenName, *tokenSymbol, supply, []common.Address{}}
+	}
+	if variant == cappedToken {
+		cap := supply
+		if *supplyCap != "" {
+			if cap, err = deployer.ParseSupply(*supplyCap, uint8(*tokenDecimals)); err != nil {
+				fatalf("Failed to parse cap: %v", err)
+			}
+		}
+		ctorArgs = append(ctorArgs, cap)
+	}
+	if variant == wrappedToken {
+		ctorArgs = []interface{}{*tokenName, *tokenSymbol}
+	}
+	if variant == taxToken {
+		// Any nonzero tax and treasury cost the same to store.
+		ctorArgs = append(ctorArgs, uint16(100), common.HexToAddress("0x000000000000000000000000000000000000dEaD"))
+	}
+	// The constructor mints to the sender, which must not be the zero address.
+	msg := ethereum.CallMsg{From: common.HexToAddress("0x000000000000000000000000000000000000dEaD")}
+	if *from != "" {
+		if msg.From, err = parseAddress(*from); err != nil {
+			fatalf("Invalid -from address: %v", err)
+		}
+	}
+	if msg.Data, err = variant.DeployData(ctorArgs...); err != nil {
+		fatalf("Failed to encode deployment data: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	gas, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		fatal(txError("estimate deployment gas", err))
+	}
+	gasPrice, err := withRetry(ctx, "suggest gas price", func() (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+	if err != nil {
+		fatalf("Failed to suggest gas price: %v", err)
+	}
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+
+	fmt.Printf("Variant: %s\n", variant.Name)
+	fmt.Printf("Gas: %d\n", gas)
+	fmt.Printf("Gas price: %s Gwei\n", formatAmount(gasPrice, 9))
+	fmt.Printf("Cost: %s ETH\n", formatAmount(cost, 18))
+
+	// The fiat quote is optional, so without -price-api there's no line for it.
+	if *priceAPI == "" {
+		return
+	}
+	price, err := fetchNativePrice(ctx, *priceAPI, *priceField)
+	if err != nil {
+		fatalf("Failed to get the native token price: %v", err)
+	}
+	fiat := new(big.Float).Mul(new(big.Float).SetInt(cost), price)
+	fiat.Quo(fiat, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+	fmt.Printf("Cost in USD: $%s (at $%s per ETH)\n", fiat.Text('f', 2), price.Text('f', 2))
+}
+
+// fetchNativePrice gets the price of the native token from a JSON API. The
+// price is the number at the dot-separated field path, or without a path the
+// only number in the response, which may also be a bare number.
+func fetchNativePrice(ctx context.Context, endpoint, field string) (*big.Float, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid JSON response: %w", err)
+	}
+
+	var value interface{}
+	if field != "" {
+		value = body
+		for _, key := range strings.Split(field, ".") {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("no field %q in the response", field)
+			}
+			if value, ok = object[key]; !ok {
+				return nil, fmt.Errorf("no field %q in the response", field)
+			}
+		}
+	} else {
+		numbers := jsonNumbers(body)
+		if len(numbers) != 1 {
+			return nil, fmt.Errorf("the response holds %d numbers, pick the price with -price-field", len(numbers))
+		}
+		value = numbers[0]
+	}
+
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		// Some APIs quote prices to keep their precision.
+		text = v
+	default:
+		return nil, fmt.Errorf("field %q is not a number", field)
+	}
+	price, ok := new(big.Float).SetString(text)
+	if !ok || price.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid price %q", text)
+	}
+	return price, nil
+}
+
+// jsonNumbers returns every number in a decoded JSON value.
+func jsonNumbers(value interface{}) []json.Number {
+	switch v := value.(type) {
+	case json.Number:
+		return []json.Number{v}
+	case map[string]interface{}:
+		var numbers []json.Number
+		for _, item := range v {
+			numbers = append(numbers, jsonNumbers(item)...)
+		}
+		return numbers
+	case []interface{}:
+		var numbers []json.Number
+		for _, item := range v {
+			numbers = append(numbers, jsonNumbers(item)...)
+		}
+		return numbers
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	etherscanAPIKey string
+	etherscanURL    string
+)
+
+// etherscanResponse is the envelope of every Etherscan API response.
+type etherscanResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Result  string `json:"result"`
+}
+
+// verifyOnEtherscan submits the source of the contract at address to the
+// Etherscan v2 API for the connected chain and polls until verification
+// passes or fails.
+func verifyOnEtherscan(ctx context.Context, w io.Writer, client *ethclient.Client, variant tokenVariant, address common.Address, constructorArgs []byte) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	bundle, err := loadSourceBundle(artifactsDir, variant)
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{
+		"module":                {"contract"},
+		"action":                {"verifysourcecode"},
+		"apikey":                {etherscanAPIKey},
+		"contractaddress":       {address.Hex()},
+		"sourceCode":            {string(bundle.Input)},
+		"codeformat":            {"solidity-standard-json-input"},
+		"contractname":          {bundle.FullyQualifiedName()},
+		"compilerversion":       {"v" + bundle.CompilerVersion},
+		"constructorArguements": {hex.EncodeToString(constructorArgs)},
+	}
+	endpoint := etherscanURL + "?chainid=" + chainID.String()
+
+	fmt.Fprintf(w, "Submitting %s for verification on Etherscan...\n", bundle.FullyQualifiedName())
+
+	// Etherscan may not have indexed the new contract yet, in which case the
+	// submission is retried for a while.
+	var guid string
+	for attempt := 0; ; attempt++ {
+		resp, err := etherscanRequest(ctx, http.MethodPost, endpoint, form)
+		if err != nil {
+			return err
+		}
+		if resp.Status == "1" {
+			guid = resp.Result
+			break
+		}
+		if strings.Contains(resp.Result, "Already Verified") {
+			fmt.Fprintf(w, "Contract is already verified.\n")
+			return nil
+		}
+		if !strings.Contains(resp.Result, "Unable to locate ContractCode") || attempt == 5 {
+			return fmt.Errorf("submission rejected: %s", resp.Result)
+		}
+		if err := sleepContext(ctx, 5*time.Second); err != nil {
+			return err
+		}
+	}
+
+	status := url.Values{
+		"module": {"contract"},
+		"action": {"checkverifystatus"},
+		"apikey": {etherscanAPIKey},
+		"guid":   {guid},
+	}
+	for attempt := 0; attempt < 30; attempt++ {
+		if err := sleepContext(ctx, 5*time.Second); err != nil {
+			return fmt.Errorf("verification still pending, check guid %s on Etherscan: %w", guid, err)
+		}
+		resp, err := etherscanRequest(ctx, http.MethodGet, endpoint+"&"+status.Encode(), nil)
+		if err != nil {
+			return err
+		}
+		switch {
+		case strings.Contains(resp.Result, "Pending"):
+			continue
+		case resp.Status == "1" || strings.Contains(resp.Result, "Already Verified"):
+			fmt.Fprintf(w, "Verification successful: %s\n", resp.Result)
+			return nil
+		default:
+			return fmt.Errorf("verification failed: %s", resp.Result)
+		}
+	}
+	return fmt.Errorf("verification still pending, check guid %s on Etherscan", guid)
+}
+
+func etherscanRequest(ctx context.Context, method, endpoint string, form url.Values) (*etherscanResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("etherscan request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result etherscanResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("invalid etherscan response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// Exit codes of the command, documented in the README, so scripts can tell
+// failure classes apart without parsing stderr.
+const (
+	exitFailure  = 1 // any other failure, e.g. insufficient funds
+	exitUsage    = 2 // invalid flags, parameters or input files
+	exitNetwork  = 3 // the RPC endpoint is unreachable or rejected a request
+	exitReverted = 4 // a transaction reverted or would revert
+	exitTimeout  = 5 // -timeout elapsed
+)
+
+// exitStatus is the exit code of a command that ran to the end but failed,
+// e.g. because its transaction reverted.
+var exitStatus int
+
+// exitError attaches an exit code to an error.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExitCode returns err with the exit code the command ends with when it
+// fails with err.
+func withExitCode(code int, err error) error {
+	return &exitError{code: code, err: err}
+}
+
+// exitCode classifies err into one of the exit codes.
+func exitCode(err error) int {
+	var coded *exitError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return exitTimeout
+	}
+	if errors.Is(err, deployer.ErrDeployReverted) || isRevert(err) {
+		return exitReverted
+	}
+	if errors.Is(err, deployer.ErrInvalidParams) || errors.Is(err, deployer.ErrInvalidKey) || errors.Is(err, deployer.ErrNoReplayProtection) ||
+		errors.Is(err, deployer.ErrNoDynamicFees) {
+		return exitUsage
+	}
+	if errors.Is(err, deployer.ErrInsufficientFunds) {
+		return exitFailure
+	}
+	var netErr net.Error
+	var httpErr rpc.HTTPError
+	var rpcErr rpc.Error
+	if errors.Is(err, deployer.ErrRPC) || errors.As(err, &netErr) || errors.As(err, &httpErr) || errors.As(err, &rpcErr) {
+		return exitNetwork
+	}
+	return exitFailure
+}
+
+// isRevert reports whether err is the node reporting that a call or gas
+// estimation reverted, with or without a reason.
+func isRevert(err error) bool {
+	if _, ok := revertReason(err); ok {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// failureCode returns the exit code of a fatal message with args. A message
+// without an underlying error reports bad input.
+func failureCode(args []interface{}) int {
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			return exitCode(err)
+		}
+	}
+	return exitUsage
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func runExportTransfers(args []string) {
+	fs := newFlagSet("export-transfers")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	fromBlock := fs.Uint64("from-block", 0, "First block to export transfers from, e.g. the deployment block")
+	toBlock := fs.Int64("to-block", -1, "Last block to export transfers from (default: the latest block)")
+	chunk := fs.Uint64("chunk", 5000, "Blocks per eth_getLogs request, halved automatically when the provider returns too many results")
+	out := fs.String("out", "", "CSV file to write the transfers to")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *out == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -out")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if *chunk == 0 {
+		fatal("The -chunk must be at least 1 block")
+	}
+	if *toBlock >= 0 && uint64(*toBlock) < *fromBlock {
+		fatalf("The -to-block %d is before the -from-block %d", *toBlock, *fromBlock)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := instance.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	end := uint64(*toBlock)
+	if *toBlock < 0 {
+		end, err = withRetry(ctx, "get block number", func() (uint64, error) {
+			return client.BlockNumber(ctx)
+		})
+		if err != nil {
+			fatalf("Failed to get the latest block: %v", err)
+		}
+	}
+
+	file, err := os.Create(*out)
+	if err != nil {
+		fatalf("Failed to create %s: %v", *out, err)
+	}
+	defer file.Close()
+	w := csv.NewWriter(file)
+	w.Write([]string{"from", "to", "value", "rawValue", "blockNumber", "txHash"})
+
+	count := 0
+	err = forEachTransfer(ctx, instance, *fromBlock, end, *chunk, func(event *ERC20TokenTransfer) error {
+		count++
+		return w.Write([]string{
+			event.From.Hex(),
+			event.To.Hex(),
+			formatAmount(event.Value, decimals),
+			event.Value.String(),
+			strconv.FormatUint(event.Raw.BlockNumber, 10),
+			event.Raw.TxHash.Hex(),
+		})
+	})
+	w.Flush()
+	if err == nil {
+		err = w.Error()
+	}
+	if err != nil {
+		fatalf("Failed to export transfers: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		fatalf("Failed to write %s: %v", *out, err)
+	}
+	fmt.Printf("Exported %d transfers in blocks %d-%d to %s\n", count, *fromBlock, end, *out)
+}
+
+// forEachTransfer calls fn for each Transfer event of the token in blocks
+// from to to, in order, fetching chunk blocks per eth_getLogs request. When
+// the provider refuses a request for returning too many logs the chunk is
+// halved and the range retried.
+func forEachTransfer(ctx context.Context, instance *ERC20Token, from, to, chunk uint64, fn func(*ERC20TokenTransfer) error) error {
+	for start := from; start <= to; {
+		end := min(start+chunk-1, to)
+		slog.Debug("Fetching transfers", "from", start, "to", end)
+		it, err := instance.FilterTransfer(&bind.FilterOpts{Start: start, End: &end, Context: ctx}, nil, nil)
+		var events []*ERC20TokenTransfer
+		if err == nil {
+			for it.Next() {
+				events = append(events, it.Event)
+			}
+			err = it.Error()
+			it.Close()
+		}
+		if err != nil {
+			if chunk > 1 && isLogLimitError(err) {
+				chunk /= 2
+				slog.Info("Too many logs in one request, halving the block range", "blocks", chunk, "err", err)
+				continue
+			}
+			return fmt.Errorf("failed to fetch transfers in blocks %d-%d: %w", start, end, err)
+		}
+		// Events are only passed on once the whole range was read, so a range
+		// retried with a smaller chunk is not reported twice.
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// isLogLimitError reports whether err is a provider refusing an eth_getLogs
+// request for its size, e.g. "query returned more than 10000 results".
+func isLogLimitError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, limit := range []string{"more than", "too many", "exceed", "range is too", "response size", "limit"} {
+		if strings.Contains(msg, limit) {
+			return true
+		}
+	}
+	return false
+}
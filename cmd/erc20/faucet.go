@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// faucetEnvPrefix is the prefix of the per-network faucet URL variables, e.g.
+// TOKKEN_FAUCET_SEPOLIA.
+const faucetEnvPrefix = "TOKKEN_FAUCET_"
+
+// faucetPollInterval is how often the balance is checked while waiting for
+// faucet funds.
+const faucetPollInterval = 5 * time.Second
+
+func runFaucet(args []string) {
+	fs := newFlagSet("faucet")
+	addRPCFlag(fs)
+	addAccountFlags(fs)
+	address := fs.String("address", "", "Address to fund (defaults to the configured account)")
+	faucetURL := fs.String("faucet-url", "", "Faucet endpoint to POST the address to (defaults to $"+faucetEnvPrefix+"<NETWORK>, then the preset's faucet)")
+	fs.Parse(args)
+
+	if networkName == "" {
+		fatal("The -network flag is required, the faucet only works with testnet presets")
+	}
+	preset, err := lookupNetwork(networkName)
+	if err != nil {
+		fatal(err)
+	}
+	if !preset.Testnet {
+		fatalf("Network %s is not a testnet, there is no faucet for it", preset.Name)
+	}
+
+	endpoint := *faucetURL
+	if endpoint == "" {
+		endpoint = os.Getenv(faucetEnvPrefix + strings.ToUpper(preset.Name))
+	}
+	if endpoint == "" {
+		endpoint = preset.Faucet
+	}
+	if endpoint == "" {
+		fatalf("No faucet configured for %s, pass -faucet-url or set $%s%s", preset.Name, faucetEnvPrefix, strings.ToUpper(preset.Name))
+	}
+
+	var recipient common.Address
+	if *address != "" {
+		if recipient, err = parseAddress(*address); err != nil {
+			fatalf("Invalid address: %v", err)
+		}
+	} else {
+		account, err := loadSigner()
+		if err != nil {
+			fatalf("Failed to load account to fund: %v", err)
+		}
+		recipient = account.address
+		account.Close()
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	before, err := client.BalanceAt(ctx, recipient, nil)
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	fmt.Printf("Current balance of %s: %s ETH\n", recipient.Hex(), formatAmount(before, 18))
+
+	if err := requestFaucetFunds(ctx, endpoint, preset.Name, recipient); err != nil {
+		fatalf("Faucet request failed: %v", err)
+	}
+	fmt.Printf("Faucet request accepted, waiting for the funds to arrive...\n")
+
+	after, err := waitForBalanceIncrease(ctx, client, recipient, before)
+	if err != nil {
+		fatal(err)
+	}
+	fmt.Printf("Funds received: %s ETH\n", formatAmount(new(big.Int).Sub(after, before), 18))
+	fmt.Printf("New balance: %s ETH\n", formatAmount(after, 18))
+}
+
+// requestFaucetFunds asks the faucet at endpoint to fund address by POSTing
+// {"address": ..., "network": ...} as JSON. Any 2xx response is success.
+func requestFaucetFunds(ctx context.Context, endpoint, network string, address common.Address) error {
+	body, err := json.Marshal(map[string]string{"address": address.Hex(), "network": network})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// waitForBalanceIncrease polls the balance of address until it rises above
+// before, returning the new balance, or until the context ends.
+func waitForBalanceIncrease(ctx context.Context, client *ethclient.Client, address common.Address, before *big.Int) (*big.Int, error) {
+	ticker := time.NewTicker(faucetPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no funds arrived at %s before the timeout, raise -timeout if the faucet is slow", address.Hex())
+		case <-ticker.C:
+		}
+		balance, err := client.BalanceAt(ctx, address, nil)
+		if err != nil {
+			continue
+		}
+		if balance.Cmp(before) > 0 {
+			return balance, nil
+		}
+	}
+}
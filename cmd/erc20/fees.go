@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+var (
+	feeStrategy      = "node"
+	feePercentile    float64
+	feeHistoryBlocks uint64
+	txType           deployer.TxType
+)
+
+// feeStrategyFlag is the flag.Value behind -fee-strategy. With "node" the
+// priority fee is the node's eth_maxPriorityFeePerGas suggestion, with
+// "history" it is derived from the tips paid in recent blocks.
+type feeStrategyFlag struct{}
+
+func (feeStrategyFlag) String() string {
+	return feeStrategy
+}
+
+func (feeStrategyFlag) Set(value string) error {
+	switch value {
+	case "node", "history":
+		feeStrategy = value
+		return nil
+	}
+	return fmt.Errorf("unknown fee strategy %q, expected node or history", value)
+}
+
+// txTypeFlag is the flag.Value behind -tx-type.
+type txTypeFlag struct{}
+
+func (txTypeFlag) String() string {
+	switch txType {
+	case deployer.TxTypeLegacy:
+		return "legacy"
+	case deployer.TxTypeDynamic:
+		return "dynamic"
+	}
+	return "auto"
+}
+
+func (txTypeFlag) Set(value string) error {
+	switch value {
+	case "auto":
+		txType = deployer.TxTypeAuto
+	case "legacy":
+		txType = deployer.TxTypeLegacy
+	case "dynamic":
+		txType = deployer.TxTypeDynamic
+	default:
+		return fmt.Errorf("unknown transaction type %q, expected auto, legacy or dynamic", value)
+	}
+	return nil
+}
+
+// historyFees derives EIP-1559 fees from eth_feeHistory over the last
+// -fee-blocks blocks. The tip is the median over those blocks of the
+// -fee-percentile tip paid in each, leaving out empty blocks, which report a
+// zero tip. The base fee is the one of the next block.
+func historyFees(ctx context.Context, client *ethclient.Client) (tip, baseFee *big.Int, err error) {
+	history, err := withRetry(ctx, "get fee history", func() (*ethereum.FeeHistory, error) {
+		return client.FeeHistory(ctx, feeHistoryBlocks, nil, []float64{feePercentile})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(history.BaseFee) == 0 {
+		return nil, nil, fmt.Errorf("fee history has no base fees")
+	}
+
+	var tips []*big.Int
+	for i, reward := range history.Reward {
+		if len(reward) == 0 || (i < len(history.GasUsedRatio) && history.GasUsedRatio[i] == 0) {
+			continue
+		}
+		tips = append(tips, reward[0])
+	}
+	if len(tips) == 0 {
+		return nil, nil, fmt.Errorf("no transactions in the last %d blocks", feeHistoryBlocks)
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	tip = tips[len(tips)/2]
+	baseFee = history.BaseFee[len(history.BaseFee)-1]
+
+	slog.Debug("Fee history", "blocks", len(history.Reward), "withTxs", len(tips), "percentile", feePercentile, "tip", tip, "nextBaseFee", baseFee)
+	return tip, baseFee, nil
+}
+
+// checkGasPriceCeiling fails when the gas price of auth, or its max fee for
+// EIP-1559 transactions, is above -max-gasprice. With -yes it only warns.
+func checkGasPriceCeiling(auth *bind.TransactOpts) error {
+	ceiling := maxGasPriceGwei.Wei()
+	if ceiling == nil {
+		return nil
+	}
+	price, kind, key := auth.GasPrice, "gas price", "gasPrice"
+	if price == nil {
+		price, kind, key = auth.GasFeeCap, "max fee", "maxFee"
+	}
+	if price == nil || price.Cmp(ceiling) <= 0 {
+		return nil
+	}
+	current, limit := formatAmount(price, 9)+" Gwei", formatAmount(ceiling, 9)+" Gwei"
+	if assumeYes {
+		slog.Warn("Sending above -max-gasprice because of -yes", key, current, "ceiling", limit)
+		return nil
+	}
+	return fmt.Errorf("the %s of %s is above the -max-gasprice ceiling of %s, wait for fees to drop, raise the ceiling or pass -yes to send anyway", kind, current, limit)
+}
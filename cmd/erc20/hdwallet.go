@@ -0,0 +1,79 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// deriveMnemonicKey validates a BIP-39 mnemonic and derives the private key
+// at the given BIP-32 path from its seed. The mnemonic and derived key are
+// never logged.
+func deriveMnemonicKey(phrase, path string) (*ecdsa.PrivateKey, error) {
+	phrase = strings.Join(strings.Fields(phrase), " ")
+	switch words := len(strings.Fields(phrase)); words {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("invalid mnemonic: expected 12, 15, 18, 21 or 24 words, got %d", words)
+	}
+
+	if !bip39.IsMnemonicValid(phrase) {
+		return nil, fmt.Errorf("invalid mnemonic: unknown word or bad checksum")
+	}
+	seed := bip39.NewSeed(phrase, "")
+
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HD path %q: %w", path, err)
+	}
+	return deriveHDKey(seed, derivationPath)
+}
+
+// deriveHDKey walks a BIP-32 derivation path from the master key of seed,
+// using private (and, for hardened indexes, hardened) child derivation.
+func deriveHDKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	curveOrder := crypto.S256().Params().N
+
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	key, chainCode := sum[:32], sum[32:]
+
+	for _, index := range path {
+		var data []byte
+		if index >= 0x80000000 {
+			data = append([]byte{0}, key...)
+		} else {
+			parent, err := crypto.ToECDSA(key)
+			if err != nil {
+				return nil, err
+			}
+			data = crypto.CompressPubkey(&parent.PublicKey)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(curveOrder) >= 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		child := tweak.Add(tweak, new(big.Int).SetBytes(key))
+		child.Mod(child, curveOrder)
+		if child.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		key, chainCode = child.FillBytes(make([]byte, 32)), sum[32:]
+	}
+	return crypto.ToECDSA(key)
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// historyEntry is the line appended to the deployment history for every
+// deployment written with -out.
+type historyEntry struct {
+	Time            time.Time `json:"time"`
+	Network         string    `json:"network"`
+	ChainID         uint64    `json:"chainId"`
+	Contract        string    `json:"contract"`
+	Name            string    `json:"name"`
+	Symbol          string    `json:"symbol"`
+	Address         string    `json:"address"`
+	TransactionHash string    `json:"transactionHash"`
+	Record          string    `json:"record"` // absolute path of the -out file
+}
+
+// historyPath returns where the deployment history is kept,
+// ~/.tokken/history.jsonl.
+func historyPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the home directory for the deployment history: %w", err)
+	}
+	return filepath.Join(home, ".tokken", "history.jsonl"), nil
+}
+
+// appendHistory adds the deployment in record, written to out, to the
+// deployment history. The deployment already succeeded, so a failure is
+// only logged.
+func appendHistory(record *deploymentRecord, out string) {
+	network := record.Network
+	if network == "" {
+		network = chainName(new(big.Int).SetUint64(record.ChainID))
+	}
+	if network == "unknown" {
+		network = fmt.Sprintf("chain %d", record.ChainID)
+	}
+	if abs, err := filepath.Abs(out); err == nil {
+		out = abs
+	}
+	entry := historyEntry{
+		Time:            time.Now().UTC().Truncate(time.Second),
+		Network:         network,
+		ChainID:         record.ChainID,
+		Contract:        record.Token.Contract,
+		Name:            record.Token.Name,
+		Symbol:          record.Token.Symbol,
+		Address:         record.ContractAddress,
+		TransactionHash: record.TransactionHash,
+		Record:          out,
+	}
+	if err := writeHistoryEntry(entry); err != nil {
+		slog.Warn("Failed to add the deployment to the history", "err", err)
+	}
+}
+
+// writeHistoryEntry appends entry as one JSON line to the history file.
+func writeHistoryEntry(entry historyEntry) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func runListDeployments(args []string) {
+	fs := newFlagSet("list-deployments")
+	network := fs.String("network", "", "Only list deployments on this network, by preset name or chain ID")
+	symbol := fs.String("symbol", "", "Only list tokens with this symbol, ignoring case")
+	fs.Parse(args)
+
+	path, err := historyPath()
+	if err != nil {
+		fatal(err)
+	}
+	entries, err := readHistory(path)
+	if errors.Is(err, os.ErrNotExist) {
+		fmt.Printf("No deployments recorded yet; deployments written with -out are added to %s\n", path)
+		return
+	}
+	if err != nil {
+		fatal(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tNETWORK\tNAME\tSYMBOL\tADDRESS")
+	listed := 0
+	for _, e := range entries {
+		if *network != "" && !strings.EqualFold(e.Network, *network) && *network != fmt.Sprint(e.ChainID) {
+			continue
+		}
+		if *symbol != "" && !strings.EqualFold(e.Symbol, *symbol) {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Time.Local().Format("2006-01-02 15:04"), e.Network, e.Name, e.Symbol, e.Address)
+		listed++
+	}
+	if listed == 0 {
+		fmt.Println("No deployments match the filters")
+		return
+	}
+	w.Flush()
+}
+
+// readHistory reads the entries of the deployment history at path, oldest
+// first. Lines that don't parse, e.g. one cut short by a crash, are skipped
+// with a warning.
+func readHistory(path string) ([]historyEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []historyEntry
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var e historyEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			slog.Warn("Skipping an unreadable line of the deployment history", "path", path, "line", line, "err", err)
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the deployment history: %w", err)
+	}
+	return entries, nil
+}
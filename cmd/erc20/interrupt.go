@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	exitMu sync.Mutex
+	// exitHooks run before the program exits through exit, e.g. from fatal,
+	// because os.Exit skips deferred calls.
+	exitHooks []func()
+	// broadcasts holds the hashes of the transactions sent so far, reported
+	// if the command is interrupted.
+	broadcasts []common.Hash
+)
+
+// atExit registers f to run when the program exits through exit.
+func atExit(f func()) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+	exitHooks = append(exitHooks, f)
+}
+
+// exit runs the exit hooks, most recent first, and exits with code.
+func exit(code int) {
+	exitMu.Lock()
+	hooks := exitHooks
+	exitHooks = nil
+	exitMu.Unlock()
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hooks[i]()
+	}
+	os.Exit(code)
+}
+
+// recordBroadcast remembers a transaction that was sent to the network.
+func recordBroadcast(hash common.Hash) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+	broadcasts = append(broadcasts, hash)
+}
+
+// commandContext returns the context a command runs under. It is cancelled
+// once -timeout elapses, unless that is zero, or when the program gets SIGINT
+// or SIGTERM. On a signal the transactions already broadcast are logged right
+// away, so their hashes are not lost whatever the command is doing at the
+// time.
+func commandContext() (context.Context, context.CancelFunc) {
+	ctx, interrupt := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	done := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-signals:
+			reportInterrupt(sig)
+			interrupt()
+		case <-done:
+		}
+	}()
+
+	cancel := context.CancelFunc(func() {})
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			cancel()
+			signal.Stop(signals)
+			close(done)
+			interrupt()
+		})
+	}
+}
+
+// reportInterrupt logs the signal and every transaction broadcast so far.
+func reportInterrupt(sig os.Signal) {
+	exitMu.Lock()
+	hashes := append([]common.Hash(nil), broadcasts...)
+	exitMu.Unlock()
+
+	if len(hashes) == 0 {
+		slog.Warn("Interrupted", "signal", sig)
+		return
+	}
+	for _, hash := range hashes {
+		slog.Warn("Interrupted after broadcasting, follow the transaction with 'erc20 track -tx "+hash.Hex()+"'", "signal", sig, "tx", hash.Hex())
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// Environment variables consulted for the signing key when no key source is
+// given on the command line.
+const (
+	privateKeyEnv = "TOKKEN_PRIVATE_KEY"
+	mnemonicEnv   = "TOKKEN_MNEMONIC"
+)
+
+// loadPrivateKey resolves the signing key from, in order of precedence, -key,
+// -keystore, -mnemonic, the TOKKEN_PRIVATE_KEY and TOKKEN_MNEMONIC environment
+// variables, and an interactive prompt. Only one of the command line sources
+// may be set; the prompt is only shown when stdin is a terminal.
+func loadPrivateKey() (*ecdsa.PrivateKey, error) {
+	flagSources := 0
+	for _, source := range []string{privateKey, keystorePath, mnemonic} {
+		if source != "" {
+			flagSources++
+		}
+	}
+	if flagSources > 1 {
+		return nil, fmt.Errorf("only one of -key, -keystore and -mnemonic may be set")
+	}
+
+	envKey, envMnemonic := os.Getenv(privateKeyEnv), os.Getenv(mnemonicEnv)
+	if flagSources > 0 && (envKey != "" || envMnemonic != "") {
+		slog.Warn("Ignoring key environment variables because a key was given on the command line", "vars", "$"+privateKeyEnv+", $"+mnemonicEnv)
+	}
+
+	switch {
+	case privateKey != "":
+	case keystorePath != "":
+		return decryptKeystore(keystorePath)
+	case mnemonic != "":
+		return deriveMnemonicKey(mnemonic, hdPath)
+	case envKey != "":
+		privateKey = envKey
+	case envMnemonic != "":
+		return deriveMnemonicKey(envMnemonic, hdPath)
+	case !stdinIsTerminal():
+		return nil, fmt.Errorf("a private key is required: set -key, -keystore, -mnemonic or $%s", privateKeyEnv)
+	case !promptForPrivateKey():
+		return nil, fmt.Errorf("a private key is required: set -key, -keystore, -mnemonic or $%s", privateKeyEnv)
+	}
+
+	return deployer.ParseKey(privateKey)
+}
+
+// decryptKeystore decrypts a V3 keystore file with the -passphrase value or a
+// prompted passphrase. The passphrase bytes are zeroed once decryption is done.
+func decryptKeystore(path string) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %w", err)
+	}
+
+	pass := []byte(passphrase)
+	passphrase = ""
+	if len(pass) == 0 {
+		pass = promptForPassphrase()
+	}
+	defer func() {
+		for i := range pass {
+			pass[i] = 0
+		}
+	}()
+
+	key, err := keystore.DecryptKey(keyJSON, string(pass))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %w", err)
+	}
+	return key.PrivateKey, nil
+}
+
+func promptForPrivateKey() bool {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Fprint(os.Stderr, "Enter your private key (without 0x prefix): ")
+	key, err := reader.ReadString('\n')
+	if err != nil {
+		fatalf("Failed to read private key: %v", err)
+	}
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return false
+	}
+	privateKey = key
+	return true
+}
+
+func promptForPassphrase() []byte {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Fprint(os.Stderr, "Enter the keystore passphrase: ")
+	pass, err := reader.ReadBytes('\n')
+	if err != nil && len(pass) == 0 {
+		fatalf("Failed to read passphrase: %v", err)
+	}
+	return bytes.TrimRight(pass, "\r\n")
+}
+
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/usbwallet"
+)
+
+// openLedger opens the first connected Ledger device and derives the account
+// at the given HD path.
+func openLedger(path string) (*signer, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HD path %q: %w", path, err)
+	}
+
+	hub, err := usbwallet.NewLedgerHub()
+	if err != nil {
+		return nil, fmt.Errorf("failed to access USB devices: %w", err)
+	}
+	wallets := hub.Wallets()
+	if len(wallets) == 0 {
+		return nil, fmt.Errorf("no Ledger device found, make sure it is connected")
+	}
+
+	wallet := wallets[0]
+	if err := wallet.Open(""); err != nil {
+		return nil, fmt.Errorf("failed to open Ledger: %w", err)
+	}
+
+	if status, _ := wallet.Status(); strings.Contains(status, "offline") {
+		wallet.Close()
+		return nil, fmt.Errorf("Ledger is locked or the Ethereum app is not open, unlock the device and open the app")
+	}
+
+	account, err := wallet.Derive(derivationPath, true)
+	if err != nil {
+		wallet.Close()
+		return nil, fmt.Errorf("failed to derive account %s: %w", path, err)
+	}
+
+	return &signer{address: account.Address, wallet: wallet, account: account}, nil
+}
+
+// ledgerSignError turns the terse errors of a failed Ledger signature into
+// an actionable message.
+func ledgerSignError(err error) error {
+	if strings.Contains(err.Error(), "reply lacks signature") {
+		return fmt.Errorf("transaction was rejected on the Ledger device")
+	}
+	if err == accounts.ErrWalletClosed {
+		return fmt.Errorf("Ledger was disconnected or locked while signing")
+	}
+	return fmt.Errorf("Ledger signing failed: %w", err)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// logLevel is the minimum level of diagnostics written to stderr, set with
+// -loglevel. Results go to stdout and are not affected by it.
+var logLevel = new(slog.LevelVar)
+
+func init() {
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: logLevel,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// Timestamps are noise for a short-lived command.
+			if len(groups) == 0 && a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})))
+}
+
+// logLevelFlag is the flag.Value behind -loglevel.
+type logLevelFlag struct{}
+
+func (logLevelFlag) String() string {
+	return strings.ToLower(logLevel.Level().String())
+}
+
+func (logLevelFlag) Set(value string) error {
+	switch strings.ToLower(value) {
+	case "debug":
+		logLevel.Set(slog.LevelDebug)
+	case "info":
+		logLevel.Set(slog.LevelInfo)
+	case "warn", "warning":
+		logLevel.Set(slog.LevelWarn)
+	case "error":
+		logLevel.Set(slog.LevelError)
+	default:
+		return fmt.Errorf("unknown log level %q, expected debug, info, warn or error", value)
+	}
+	return nil
+}
+
+// fatalf logs a formatted message at error level and exits with the code
+// of the first error among args.
+func fatalf(format string, args ...interface{}) {
+	slog.Error(fmt.Sprintf(format, args...))
+	exit(failureCode(args))
+}
+
+// fatalfCode is fatalf with an explicit exit code.
+func fatalfCode(code int, format string, args ...interface{}) {
+	slog.Error(fmt.Sprintf(format, args...))
+	exit(code)
+}
+
+// fatal logs its arguments at error level and exits with the code of the
+// first error among them.
+func fatal(args ...interface{}) {
+	slog.Error(fmt.Sprint(args...))
+	exit(failureCode(args))
+}
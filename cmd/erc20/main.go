@@ -1,167 +1,439 @@
 package main
 
 import (
-	"bufio"
 	"context"
-	"crypto/ecdsa"
+	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"math/big"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
 )
 
+// command is a subcommand of the CLI, selected by the first argument.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string)
+}
+
+// commands is populated in init because the command usage texts refer back to it.
+var commands []command
+
+func init() {
+	commands = []command{
+		{"deploy", "Deploy a new ERC20 token (default when no command is given)", runDeploy},
+		{"serve", "Accept deploy requests over HTTP, optionally exposing Prometheus metrics", runServe},
+		{"token-info", "Show name, symbol, decimals and total supply of any ERC20", runTokenInfo},
+		{"preflight", "Check that an RPC endpoint is reachable, in sync and supports EIP-1559", runPreflight},
+		{"track", "Follow an already broadcast transaction and show its outcome", runTrack},
+		{"speedup", "Rebroadcast a pending transaction with a higher gas price", runSpeedup},
+		{"cancel", "Replace a pending transaction with an empty self-transfer", runCancel},
+		{"estimate-cost", "Quote the gas and fiat cost of a deployment", runEstimateCost},
+		{"mine-salt", "Search for a CREATE2 salt giving the token address a chosen prefix", runMineSalt},
+		{"convert", "Convert amounts between whole token units and base units", runConvert},
+		{"balance", "Query token balances of one or more addresses", runBalance},
+		{"whoami", "Show the address, balance and nonce of the configured account", runWhoami},
+		{"watch-transfers", "Stream the Transfer events of a token as they happen", runWatchTransfers},
+		{"export-transfers", "Write the Transfer events of a token in a block range to CSV", runExportTransfers},
+		{"snapshot", "Write the token holders and their balances at a block to CSV", runSnapshot},
+		{"list-deployments", "List the deployments recorded in the local history", runListDeployments},
+		{"transfer", "Transfer tokens to another address", runTransfer},
+		{"simulate-transfer", "Check with eth_call whether a transfer would succeed, without sending it", runSimulateTransfer},
+		{"airdrop", "Send tokens to every recipient listed in a CSV file", runAirdrop},
+		{"mint", "Mint new tokens on a token deployed with -mintable", runMint},
+		{"approve", "Allow another address to spend tokens", runApprove},
+		{"allowance", "Show how many tokens a spender may still spend", runAllowance},
+		{"permit", "Sign and submit an EIP-2612 permit on a token deployed with -permit", runPermit},
+		{"delegate", "Delegate voting power on a token deployed with -votes", runDelegate},
+		{"votes", "Show current or past voting power on a token deployed with -votes", runVotes},
+		{"authorize-operator", "Authorize an operator on a token deployed with -erc777", runAuthorizeOperator},
+		{"transfer-and-call", "Transfer tokens to a contract and notify it on a token deployed with -erc1363", runTransferAndCall},
+		{"burn", "Burn tokens on a token deployed with -burnable", runBurn},
+		{"pause", "Halt transfers on a token deployed with -pausable", runPause},
+		{"unpause", "Resume transfers on a token deployed with -pausable", runUnpause},
+		{"block-address", "Block an address from sending and receiving a token deployed with -restricted", runBlockAddress},
+		{"unblock-address", "Lift the block of an address on a token deployed with -restricted", runUnblockAddress},
+		{"is-blocked", "Check whether an address is blocked on a token deployed with -restricted", runIsBlocked},
+		{"wrap", "Deposit native currency into a token deployed with -wrapped, minting the same amount of tokens", runWrap},
+		{"unwrap", "Burn tokens of a token deployed with -wrapped, withdrawing the same amount of native currency", runUnwrap},
+		{"status", "Show the owner and paused state of a token", runStatus},
+		{"verify-bytecode", "Check that deployed code matches a token variant", runVerifyBytecode},
+		{"verify-attestation", "Check that a launch attestation was signed by the deployer", runVerifyAttestation},
+		{"recover", "Recover the address that signed a message or a transaction", runRecover},
+		{"generate-bindings", "Generate Go bindings for a compiled contract artifact, as abigen does", runGenerateBindings},
+		{"transfer-ownership", "Hand ownership of a token to another address", runTransferOwnership},
+		{"renounce-ownership", "Give up ownership of a token for good", runRenounceOwnership},
+		{"send-eth", "Send ether, e.g. to fund a deployer account", runSendEth},
+		{"faucet", "Request testnet ether from a faucet and wait for it to arrive", runFaucet},
+	}
+}
+
 var (
-	rpcURL        = flag.String("rpc", "", "RPC URL of the Ethereum network")
-	privateKey    = flag.String("key", "", "Private key for deployment (without 0x prefix)")
-	tokenName     = flag.String("name", "", "Name of the token")
-	tokenSymbol   = flag.String("symbol", "", "Symbol of the token")
-	tokenDecimals = flag.Uint("decimals", 18, "Number of decimals for the token")
-	totalSupply   = flag.String("supply", "", "Total supply of tokens (in whole units)")
-	gasLimit      = flag.Uint64("gas", 3000000, "Gas limit for deployment")
-	gasPriceGwei  = flag.Float64("gasprice", 0, "Gas price in Gwei (optional)")
+	rpcURL          string
+	rpcURLs         []string
+	timeout         time.Duration
+	networkName     string
+	privateKey      string
+	keystorePath    string
+	passphrase      string
+	mnemonic        string
+	useLedger       bool
+	signerURL       string
+	signerAccount   string
+	hdPath          string
+	gasLimit        uint64
+	gasPriceGwei    gweiFlag
+	maxFeeGwei      gweiFlag
+	priorityGwei    gweiFlag
+	maxGasPriceGwei gweiFlag
+	expectedChainID int64
+	assumeYes       bool
+	force           bool
+	nonceOverride   int64
 )
 
 func main() {
-	flag.Parse()
+	args := os.Args[1:]
+	if len(args) == 0 && stdinIsTerminal() {
+		runWizard()
+		return
+	}
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		runDeploy(args)
+		exit(exitStatus)
+	}
 
-	if *rpcURL == "" || (*privateKey == "" && !promptForPrivateKey()) || *tokenName == "" || *tokenSymbol == "" || *totalSupply == "" {
-		log.Fatal("All flags are required: -rpc, -key, -name, -symbol, -supply")
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			cmd.run(args[1:])
+			exit(exitStatus)
+		}
 	}
 
-	client, err := ethclient.Dial(*rpcURL)
-	if err != nil {
-		log.Fatalf("Failed to connect to the Ethereum network: %v", err)
+	fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", args[0])
+	printCommands(os.Stderr)
+	exit(exitUsage)
+}
+
+func printCommands(w io.Writer) {
+	fmt.Fprintf(w, "Usage: erc20 [command] [flags]\n\nCommands:\n")
+	width := 0
+	for _, cmd := range commands {
+		width = max(width, len(cmd.name))
 	}
-	defer client.Close()
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nRun 'erc20 <command> -h' for the flags of a command.\n")
+}
 
-	auth, err := createTransactor(*privateKey, client)
-	if err != nil {
-		log.Fatalf("Failed to create transactor: %v", err)
+// newFlagSet returns a flag set for the named command that exits on parse errors.
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: erc20 %s [flags]\n\nFlags:\n", name)
+		fs.PrintDefaults()
 	}
+	return fs
+}
 
-	supply, err := parseSupply(*totalSupply, uint8(*tokenDecimals))
-	if err != nil {
-		log.Fatalf("Failed to parse supply: %v", err)
+// addRPCFlag registers the -rpc, -rpcs, -network, -timeout and -strict flags shared by all commands.
+func addRPCFlag(fs *flag.FlagSet) {
+	fs.DurationVar(&timeout, "timeout", 2*time.Minute, "Maximum time for the whole command, including waiting for mining")
+	fs.StringVar(&rpcURL, "rpc", "", "RPC URL of the Ethereum network (overrides the -network default)")
+	fs.Var(urlListFlag{}, "rpcs", "Comma-separated RPC URLs used instead of -rpc, transactions are broadcast to all of them")
+	fs.StringVar(&networkName, "network", "", "Network preset: "+strings.Join(networkNames(), ", "))
+	fs.Var(logLevelFlag{}, "loglevel", "Diagnostics written to stderr: debug, info, warn or error (default info)")
+	fs.BoolVar(&traceRPC, "trace-rpc", false, "Log every JSON-RPC request to an HTTP endpoint with its params, latency and response to stderr, signed transactions shown only by hash")
+	fs.BoolVar(&strictAddresses, "strict", false, "Reject mixed-case addresses with a wrong EIP-55 checksum instead of warning about them")
+}
+
+// addTxFlags registers the signing and fee flags shared by commands that send transactions.
+func addTxFlags(fs *flag.FlagSet) {
+	addAccountFlags(fs)
+	fs.IntVar(&retries, "retries", 3, "Number of retries for transient RPC failures")
+	fs.DurationVar(&retryDelay, "retry-delay", time.Second, "Initial delay between retries, doubled on each attempt")
+	fs.Int64Var(&expectedChainID, "chainid", 0, "Abort unless the RPC endpoint reports this chain ID (optional)")
+	fs.BoolVar(&assumeYes, "yes", false, "Broadcast without asking for confirmation")
+	fs.BoolVar(&force, "force", false, "Send even on a chain without EIP-155 replay protection (chain ID 0), whose transactions can be replayed on other chains")
+	fs.Int64Var(&nonceOverride, "nonce", -1, "Nonce of the first transaction, e.g. to replace a stuck one together with a higher gas price (default: the next pending nonce)")
+	fs.Uint64Var(&confirmations, "confirmations", 1, "Number of blocks, including the one with the transaction, to wait for")
+	fs.Var(&gasPriceGwei, "gasprice", "Gas price in Gwei for legacy transactions (optional)")
+	fs.Var(&maxFeeGwei, "maxfee", "Max fee per gas in Gwei for EIP-1559 transactions (optional)")
+	fs.Var(&priorityGwei, "priorityfee", "Max priority fee per gas in Gwei for EIP-1559 transactions (optional)")
+	fs.Var(&maxGasPriceGwei, "max-gasprice", "Abort if the gas price, or the max fee of EIP-1559 transactions, exceeds this many Gwei, unless -yes is given (optional)")
+	fs.Var(accessListFlag{}, "access-list", "EIP-2930 access list attached to the transactions, as inline JSON or a JSON file: [{\"address\": ..., \"storageKeys\": [...]}]")
+	fs.BoolVar(&autoAccessList, "auto-access-list", false, "Attach the access list eth_createAccessList derives for each transaction when it saves gas, reporting the savings")
+	fs.Var(txTypeFlag{}, "tx-type", "Transaction type: auto (dynamic fee when the chain reports a base fee), legacy (gas price only) or dynamic (EIP-1559 fee caps, failing without a base fee)")
+	fs.Var(feeStrategyFlag{}, "fee-strategy", "How to pick the priority fee when -priorityfee is not given: node (the node's suggestion) or history (recent tips)")
+	fs.Float64Var(&feePercentile, "fee-percentile", 50, "Percentile of the tips in each block used by -fee-strategy history")
+	fs.Uint64Var(&feeHistoryBlocks, "fee-blocks", 20, "Number of recent blocks looked at by -fee-strategy history")
+}
+
+// addAccountFlags registers the flags selecting the signing account.
+func addAccountFlags(fs *flag.FlagSet) {
+	fs.StringVar(&privateKey, "key", "", "Private key for signing transactions (without 0x prefix).\nThe key is taken from -key, -keystore or -mnemonic, then $"+privateKeyEnv+", then $"+mnemonicEnv+", then an interactive prompt")
+	fs.StringVar(&keystorePath, "keystore", "", "Path to an encrypted V3 keystore file to sign with instead of -key")
+	fs.StringVar(&passphrase, "passphrase", "", "Passphrase for -keystore (prompted for if empty)")
+	fs.StringVar(&mnemonic, "mnemonic", "", "BIP-39 mnemonic to derive the signing key from at -hdpath")
+	fs.BoolVar(&useLedger, "ledger", false, "Sign with a Ledger hardware wallet instead of a private key")
+	fs.StringVar(&signerURL, "signer-url", "", "Sign with an external signer such as clef at this URL (http, ws or IPC path), keeping the keys off this machine")
+	fs.StringVar(&signerAccount, "signer-account", "", "Account of the -signer-url signer to send from (default: its only account)")
+	fs.StringVar(&hdPath, "hdpath", "m/44'/60'/0'/0/0", "HD derivation path of the signing account")
+}
+
+// dialClient connects to the endpoint given by -rpc, or to the public
+// endpoint of the -network preset. When a preset is selected, the chain ID
+// reported by the endpoint must match it. With -rpcs the first endpoint that
+// answers becomes the client and the others on the same chain are kept in
+// broadcastClients.
+func dialClient(ctx context.Context) (*ethclient.Client, error) {
+	var preset *network
+	if networkName != "" {
+		var err error
+		if preset, err = lookupNetwork(networkName); err != nil {
+			return nil, err
+		}
 	}
 
-	address, tx, instance, err := DeployERC20Token(
-		auth,
-		client,
-		*tokenName,
-		*tokenSymbol,
-		uint8(*tokenDecimals),
-		supply,
-	)
-	if err != nil {
-		log.Fatalf("Failed to deploy contract: %v", err)
+	if len(rpcURLs) > 0 {
+		if rpcURL != rpcURLs[0] {
+			return nil, fmt.Errorf("-rpc and -rpcs cannot be combined")
+		}
+		return dialEndpoints(ctx, rpcURLs, preset)
 	}
 
-	fmt.Printf("Token deployment initiated!\n")
-	fmt.Printf("Contract address: %s\n", address.Hex())
-	fmt.Printf("Transaction hash: %s\n", tx.Hash().Hex())
-	fmt.Printf("Waiting for transaction to be mined...\n")
+	url := rpcURL
+	if url == "" && preset != nil {
+		url = preset.RPC
+	}
+	if url == "" {
+		return nil, fmt.Errorf("one of -rpc or -network is required")
+	}
+	return dialEndpoint(ctx, url, preset)
+}
 
-	receipt, err := bind.WaitMined(context.Background(), client, tx)
+// dialEndpoint connects to url, checking its chain ID against preset if set.
+func dialEndpoint(ctx context.Context, url string, preset *network) (*ethclient.Client, error) {
+	client, err := dialRPC(ctx, url)
 	if err != nil {
-		log.Fatalf("Failed to wait for mining: %v", err)
+		return nil, err
 	}
+	atExit(client.Close)
+	if preset != nil {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		}
+		if chainID.Cmp(big.NewInt(preset.ChainID)) != 0 {
+			client.Close()
+			return nil, fmt.Errorf("chain ID mismatch: network %s expects %d but %s reports %s", preset.Name, preset.ChainID, url, chainID)
+		}
+	}
+	return client, nil
+}
 
-	if receipt.Status == 1 {
-		fmt.Printf("\nDeployment successful!\n")
-		fmt.Printf("Gas used: %d\n", receipt.GasUsed)
-
-		name, err := instance.Name(&bind.CallOpts{})
-		if err == nil {
-			fmt.Printf("Token name: %s\n", name)
+// dialEndpoints connects to every -rpcs endpoint. Endpoints that don't
+// answer, or that are on another chain than the first one that does, are
+// skipped with a warning.
+func dialEndpoints(ctx context.Context, urls []string, preset *network) (*ethclient.Client, error) {
+	broadcastClients = nil
+	var client *ethclient.Client
+	var chainID *big.Int
+	for _, url := range urls {
+		c, err := dialEndpoint(ctx, url, preset)
+		if err != nil {
+			slog.Warn("Skipping RPC endpoint", "url", url, "err", err)
+			continue
 		}
-		symbol, err := instance.Symbol(&bind.CallOpts{})
-		if err == nil {
-			fmt.Printf("Token symbol: %s\n", symbol)
+		id, err := c.ChainID(ctx)
+		if err != nil {
+			c.Close()
+			slog.Warn("Skipping RPC endpoint", "url", url, "err", err)
+			continue
 		}
-		decimals, err := instance.Decimals(&bind.CallOpts{})
-		if err == nil {
-			fmt.Printf("Token decimals: %d\n", decimals)
+		if client == nil {
+			slog.Debug("Using RPC endpoint", "url", url, "chainID", id)
+			client, chainID = c, id
+			continue
 		}
-	} else {
-		fmt.Printf("\nDeployment failed! Check the transaction on a block explorer.\n")
+		if id.Cmp(chainID) != 0 {
+			c.Close()
+			slog.Warn("Skipping RPC endpoint on another chain", "url", url, "chainID", id, "want", chainID)
+			continue
+		}
+		broadcastClients = append(broadcastClients, c)
 	}
+	if client == nil {
+		return nil, fmt.Errorf("none of the -rpcs endpoints is reachable")
+	}
+	return client, nil
 }
 
-func createTransactor(privateKeyHex string, client *ethclient.Client) (*bind.TransactOpts, error) {
-	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+// createTransactor returns transact options for account on the chain of
+// client, taking the nonce, gas limit and fees from the command line flags.
+func createTransactor(ctx context.Context, account *signer, client *ethclient.Client) (*bind.TransactOpts, error) {
+	cfg, err := transactorConfig(ctx, account, client)
 	if err != nil {
-		return nil, fmt.Errorf("invalid private key: %v", err)
+		return nil, err
 	}
+	return newTransactor(ctx, client, cfg)
+}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("error casting public key to ECDSA")
+// transactorConfig returns the transactor settings given by the command line
+// flags.
+func transactorConfig(ctx context.Context, account *signer, client *ethclient.Client) (deployer.TransactorConfig, error) {
+	cfg := deployer.TransactorConfig{
+		Signer:      account.transactOpts,
+		GasLimit:    gasLimit,
+		GasPrice:    gasPriceGwei.Wei(),
+		MaxFee:      maxFeeGwei.Wei(),
+		PriorityFee: priorityGwei.Wei(),
+		Retry:       retryCall,
+		// Pre-EIP-155 chains are refused unless -force is given.
+		AllowUnprotected: force,
+		TxType:           txType,
 	}
-
-	fromAddress := crypto.PubkeyToAddress(*publicKeyECDSA)
-	nonce, err := client.PendingNonceAt(context.Background(), fromAddress)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get nonce: %v", err)
+	switch {
+	case txType == deployer.TxTypeLegacy && (cfg.MaxFee != nil || cfg.PriorityFee != nil):
+		return cfg, fmt.Errorf("-maxfee and -priorityfee only apply to dynamic fee transactions, use -gasprice with -tx-type legacy")
+	case txType == deployer.TxTypeDynamic && cfg.GasPrice != nil:
+		return cfg, fmt.Errorf("-gasprice only applies to legacy transactions, use -maxfee and -priorityfee with -tx-type dynamic")
+	}
+	if force {
+		sign := cfg.Signer
+		cfg.Signer = func(chainID *big.Int) (*bind.TransactOpts, error) {
+			if chainID.Sign() == 0 {
+				slog.Warn("The chain reports chain ID 0, the transactions have no EIP-155 replay protection and can be replayed on other chains")
+			}
+			return sign(chainID)
+		}
+	}
+	if nonceOverride >= 0 {
+		pending, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+			return client.PendingNonceAt(ctx, account.address)
+		})
+		if err != nil {
+			return cfg, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		if err := checkNonceOverride(ctx, client, account.address, pending); err != nil {
+			return cfg, err
+		}
+		nonce := uint64(nonceOverride)
+		cfg.Nonce = &nonce
+	}
+	if accessList != nil || autoAccessList {
+		if accessList != nil && autoAccessList {
+			return cfg, fmt.Errorf("only one of -access-list and -auto-access-list can be given")
+		}
+		sign := cfg.Signer
+		cfg.Signer = func(chainID *big.Int) (*bind.TransactOpts, error) {
+			auth, err := sign(chainID)
+			if err != nil {
+				return nil, err
+			}
+			auth.Signer = withAccessList(ctx, client, auth.Signer, chainID)
+			return auth, nil
+		}
+	}
+	if feeStrategy == "history" {
+		if feePercentile < 0 || feePercentile > 100 {
+			return cfg, fmt.Errorf("-fee-percentile must be between 0 and 100")
+		}
+		cfg.Fees = func(ctx context.Context) (*big.Int, *big.Int, error) {
+			tip, baseFee, err := historyFees(ctx, client)
+			if err != nil {
+				slog.Warn("Fee history unavailable, falling back to the node's suggestion", "err", err)
+				return nil, nil, nil
+			}
+			return tip, baseFee, nil
+		}
 	}
+	return cfg, nil
+}
 
-	chainID, err := client.ChainID(context.Background())
+// newTransactor returns transact options with cfg, logging the outcome.
+func newTransactor(ctx context.Context, client *ethclient.Client, cfg deployer.TransactorConfig) (*bind.TransactOpts, error) {
+	auth, err := deployer.NewTransactor(ctx, client, cfg)
+	if errors.Is(err, deployer.ErrNoReplayProtection) {
+		return nil, fmt.Errorf("%w; pass -force to send anyway", err)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to get chain ID: %v", err)
+		return nil, err
 	}
+	if err := checkGasPriceCeiling(auth); err != nil {
+		return nil, err
+	}
+	slog.Debug("Transaction options", "from", auth.From.Hex(), "nonce", auth.Nonce,
+		"gasPrice", auth.GasPrice, "maxFee", auth.GasFeeCap, "priorityFee", auth.GasTipCap)
+	return auth, nil
+}
 
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+// checkNonceOverride warns when the -nonce override differs from pending,
+// the next nonce of the account counting its pending transactions. A higher
+// nonce leaves a gap the transaction waits behind, and a lower one either
+// replaces a pending transaction or, when already mined, is rejected.
+func checkNonceOverride(ctx context.Context, client *ethclient.Client, account common.Address, pending uint64) error {
+	override := uint64(nonceOverride)
+	if override == pending {
+		return nil
+	}
+	if override > pending {
+		slog.Warn("Nonce is above the next pending nonce, the transaction waits until the gap is filled", "nonce", override, "pending", pending)
+		return nil
+	}
+	mined, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+		return client.NonceAt(ctx, account, nil)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create transactor: %v", err)
+		return fmt.Errorf("failed to get nonce: %w", err)
 	}
-
-	auth.Nonce = big.NewInt(int64(nonce))
-	auth.Value = big.NewInt(0)
-
-	if *gasPriceGwei > 0 {
-		gasPriceWei := new(big.Int).Mul(big.NewInt(int64(*gasPriceGwei*1e9)), big.NewInt(1))
-		auth.GasPrice = gasPriceWei
+	if override < mined {
+		slog.Warn("Nonce is already used by a mined transaction, the node will reject it as too low", "nonce", override, "mined", mined)
 	} else {
-		gasPrice, err := client.SuggestGasPrice(context.Background())
-		if err != nil {
-			return nil, fmt.Errorf("failed to suggest gas price: %v", err)
-		}
-		auth.GasPrice = gasPrice
+		slog.Warn("Nonce belongs to a pending transaction, which is replaced only if the new gas price is higher", "nonce", override, "pending", pending)
 	}
+	return nil
+}
 
-	auth.GasLimit = *gasLimit
-
-	return auth, nil
+// gweiFlag is a flag.Value for a fee given in Gwei. The value is parsed as a
+// decimal string and converted to Wei exactly, so 1.5 Gwei is 1500000000 Wei
+// and values beyond the int64 range are kept intact.
+type gweiFlag struct {
+	wei *big.Int
 }
 
-func parseSupply(supply string, decimals uint8) (*big.Int, error) {
-	value := new(big.Int)
-	_, ok := value.SetString(supply, 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid supply value: %s", supply)
+func (g *gweiFlag) String() string {
+	if g.wei == nil {
+		return "0"
 	}
-
-	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
-	return value.Mul(value, multiplier), nil
+	return formatAmount(g.wei, 9)
 }
 
-func promptForPrivateKey() bool {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Print("Enter your private key (without 0x prefix): ")
-	key, err := reader.ReadString('\n')
+func (g *gweiFlag) Set(value string) error {
+	wei, err := deployer.ParseUnits(value, 9)
 	if err != nil {
-		log.Fatalf("Failed to read private key: %v", err)
+		return fmt.Errorf("invalid Gwei value %q: %w", value, err)
 	}
-	key = strings.TrimSpace(key)
-	if key == "" {
-		return false
+	g.wei = wei
+	return nil
+}
+
+// Wei returns the fee in Wei, or nil when the flag is unset or zero.
+func (g *gweiFlag) Wei() *big.Int {
+	if g.wei == nil || g.wei.Sign() == 0 {
+		return nil
 	}
-	*privateKey = key
-	return true
+	return new(big.Int).Set(g.wei)
 }
@@ -0,0 +1,52 @@
+package main
+
+import "testing"
+
+func TestGweiFlagSet(t *testing.T) {
+	tests := []struct {
+		value string
+		wei   string
+		str   string
+	}{
+		{value: "0.1", wei: "100000000", str: "0.1"},
+		{value: "1.5", wei: "1500000000", str: "1.5"},
+		{value: "500", wei: "500000000000", str: "500"},
+		{value: "0.000000001", wei: "1", str: "0.000000001"},
+	}
+	for _, tt := range tests {
+		var g gweiFlag
+		if err := g.Set(tt.value); err != nil {
+			t.Errorf("Set(%q) failed: %v", tt.value, err)
+			continue
+		}
+		if got := g.Wei(); got == nil || got.String() != tt.wei {
+			t.Errorf("Set(%q) gives %v Wei, want %s", tt.value, got, tt.wei)
+		}
+		if got := g.String(); got != tt.str {
+			t.Errorf("Set(%q) prints as %q, want %q", tt.value, got, tt.str)
+		}
+	}
+}
+
+func TestGweiFlagSetInvalid(t *testing.T) {
+	// Fractions of a Wei can't be sent, so they are rejected, not rounded.
+	for _, value := range []string{"0.0000000001", "-1", "1e9", "abc", ""} {
+		var g gweiFlag
+		if err := g.Set(value); err == nil {
+			t.Errorf("Set(%q) = %v Wei, want an error", value, g.Wei())
+		}
+	}
+}
+
+func TestGweiFlagZero(t *testing.T) {
+	var g gweiFlag
+	if g.Wei() != nil || g.String() != "0" {
+		t.Errorf("unset flag = %v Wei, %q, want nil, \"0\"", g.Wei(), g.String())
+	}
+	if err := g.Set("0"); err != nil {
+		t.Fatal(err)
+	}
+	if g.Wei() != nil {
+		t.Errorf("Set(\"0\") gives %v Wei, want nil", g.Wei())
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// metadataFeatures maps the features of a -metadata file to the deploy flags
+// selecting the matching variant.
+var metadataFeatures = map[string]string{
+	"mintable":   "mintable",
+	"burnable":   "burnable",
+	"pausable":   "pausable",
+	"permit":     "permit",
+	"votes":      "votes",
+	"erc777":     "erc777",
+	"erc1363":    "erc1363",
+	"restricted": "restricted",
+	"wrapped":    "wrapped",
+}
+
+// applyMetadata fills the token flags of the deploy command from a JSON
+// token definition such as
+//
+//	{"name": "My Token", "symbol": "MTK", "decimals": 18, "supply": "1000000",
+//	 "cap": "2000000", "features": ["mintable"]}
+//
+// Flags already set on the command line or by -config are left alone, so
+// they override the file.
+func applyMetadata(fs *flag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read metadata: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to parse metadata %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	for key, raw := range fields {
+		switch key {
+		case "name", "symbol":
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return fmt.Errorf("metadata field %q must be a string", key)
+			}
+			values[key] = s
+		case "decimals":
+			var d uint8
+			if err := json.Unmarshal(raw, &d); err != nil {
+				return fmt.Errorf("metadata field %q must be a whole number up to 255", key)
+			}
+			values[key] = fmt.Sprint(d)
+		case "supply", "cap":
+			amount, err := metadataAmount(raw)
+			if err != nil {
+				return fmt.Errorf("metadata field %q %w", key, err)
+			}
+			values[key] = amount
+		case "features":
+			var features []string
+			if err := json.Unmarshal(raw, &features); err != nil {
+				return fmt.Errorf("metadata field %q must be a list of strings", key)
+			}
+			for _, feature := range features {
+				name, ok := metadataFeatures[feature]
+				if !ok {
+					return fmt.Errorf("unknown feature %q in metadata %s, supported: %s", feature, path, strings.Join(supportedFeatures(), ", "))
+				}
+				values[name] = "true"
+			}
+		default:
+			return fmt.Errorf("unknown field %q in metadata %s", key, path)
+		}
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	for name, value := range values {
+		if explicit[name] {
+			continue
+		}
+		if err := fs.Set(name, value); err != nil {
+			return fmt.Errorf("invalid metadata value for %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// metadataAmount returns an amount given either as a JSON string, which may
+// use fractions and underscores like the flags, or as a plain JSON number.
+func metadataAmount(raw json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var n json.Number
+	if err := decoder.Decode(&n); err != nil {
+		return "", fmt.Errorf("must be a number or a string")
+	}
+	return n.String(), nil
+}
+
+// supportedFeatures returns the feature names of metadataFeatures, sorted.
+func supportedFeatures() []string {
+	names := make([]string, 0, len(metadataFeatures))
+	for name := range metadataFeatures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// gasUsedBuckets are the upper bounds of the gas used histogram, covering
+// the standard token up to the proxied and ERC777 variants.
+var gasUsedBuckets = []uint64{500_000, 1_000_000, 1_500_000, 2_000_000, 3_000_000, 5_000_000}
+
+// gasPriceInterval is how often the gas price gauge is refreshed.
+const gasPriceInterval = 15 * time.Second
+
+// serverMetrics counts the deployments handled by serve and exposes them in
+// the Prometheus text format. A nil *serverMetrics records nothing, so the
+// server works the same with metrics turned off.
+type serverMetrics struct {
+	mu        sync.Mutex
+	attempted uint64
+	succeeded uint64
+	failed    uint64
+	// gasCounts[i] counts the deployments that used at most
+	// gasUsedBuckets[i] gas and more than the bucket before.
+	gasCounts []uint64
+	gasSum    uint64
+	gasCount  uint64
+	// gasPrices holds the current gas price of each served network.
+	gasPrices map[string]*big.Int
+}
+
+func newServerMetrics() *serverMetrics {
+	return &serverMetrics{
+		gasCounts: make([]uint64, len(gasUsedBuckets)+1),
+		gasPrices: make(map[string]*big.Int),
+	}
+}
+
+// attempt counts a deployment about to be sent.
+func (m *serverMetrics) attempt() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempted++
+}
+
+// fail counts a deployment that failed before or after it was sent.
+func (m *serverMetrics) fail() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.failed++
+}
+
+// mined counts a mined deployment and the gas it used.
+func (m *serverMetrics) mined(gasUsed uint64, success bool) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if success {
+		m.succeeded++
+	} else {
+		m.failed++
+	}
+	i := 0
+	for i < len(gasUsedBuckets) && gasUsed > gasUsedBuckets[i] {
+		i++
+	}
+	m.gasCounts[i]++
+	m.gasSum += gasUsed
+	m.gasCount++
+}
+
+// setGasPrice records the current gas price of network in wei.
+func (m *serverMetrics) setGasPrice(network string, price *big.Int) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.gasPrices[network] = price
+}
+
+// pollGasPrice keeps the gas price gauge of network up to date until ctx
+// ends.
+func (m *serverMetrics) pollGasPrice(ctx context.Context, network string, client *ethclient.Client) {
+	ticker := time.NewTicker(gasPriceInterval)
+	defer ticker.Stop()
+	for {
+		price, err := client.SuggestGasPrice(ctx)
+		if err == nil {
+			m.setGasPrice(network, price)
+		} else if ctx.Err() == nil {
+			slog.Debug("Failed to refresh the gas price metric", "network", network, "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format.
+func (m *serverMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.write(w)
+}
+
+func (m *serverMetrics) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	counter := func(name, help string, value uint64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", name, help, name, name, value)
+	}
+	counter("erc20_deploys_attempted_total", "Deployments sent or about to be sent.", m.attempted)
+	counter("erc20_deploys_succeeded_total", "Deployments mined successfully.", m.succeeded)
+	counter("erc20_deploys_failed_total", "Deployments that failed to be sent, mined or reverted.", m.failed)
+
+	const hist = "erc20_deploy_gas_used"
+	fmt.Fprintf(w, "# HELP %s Gas used by mined deployments.\n# TYPE %s histogram\n", hist, hist)
+	var cumulative uint64
+	for i, bound := range gasUsedBuckets {
+		cumulative += m.gasCounts[i]
+		fmt.Fprintf(w, "%s_bucket{le=\"%d\"} %d\n", hist, bound, cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n%s_sum %d\n%s_count %d\n", hist, m.gasCount, hist, m.gasSum, hist, m.gasCount)
+
+	if len(m.gasPrices) > 0 {
+		const gauge = "erc20_gas_price_wei"
+		fmt.Fprintf(w, "# HELP %s Gas price suggested by the node.\n# TYPE %s gauge\n", gauge, gauge)
+		networks := make([]string, 0, len(m.gasPrices))
+		for network := range m.gasPrices {
+			networks = append(networks, network)
+		}
+		sort.Strings(networks)
+		for _, network := range networks {
+			price, _ := new(big.Float).SetInt(m.gasPrices[network]).Float64()
+			fmt.Fprintf(w, "%s{network=%q} %s\n", gauge, network, strconv.FormatFloat(price, 'g', -1, 64))
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"math"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+func runMineSalt(args []string) {
+	fs := newFlagSet("mine-salt")
+	addAccountFlags(fs)
+	prefixFlag := fs.String("prefix", "", "Hex prefix the token address should start with, e.g. 0xdead (case is ignored)")
+	factoryFlag := fs.String("factory", defaultCreate2Factory().Hex(), "CREATE2 factory the token will be deployed through, as with deploy -create2 -factory")
+	deployerFlag := fs.String("deployer", "", "Account that will send the deployment, which the factory mixes into the salt (default: the configured account)")
+	variantName := fs.String("variant", "standard", "Variant that will be deployed: standard, mintable, burnable, pausable, capped, permit, votes, erc1363 or restricted")
+	tokenName := fs.String("name", "", "Name of the token, exactly as it will be deployed")
+	tokenSymbol := fs.String("symbol", "", "Symbol of the token, exactly as it will be deployed")
+	tokenDecimals := fs.Uint("decimals", 18, "Number of decimals for the token")
+	totalSupply := fs.String("supply", "", "Total supply of tokens (in whole units)")
+	supplyCap := fs.String("cap", "", "Maximum total supply of a capped token (default: the supply)")
+	initCodeHash := fs.String("init-code-hash", "", "keccak256 of the init code to deploy, instead of the token flags, e.g. for an -artifact deployment")
+	maxAttempts := fs.Uint64("max-attempts", 1_000_000_000, "Give up after trying this many salts")
+	workers := fs.Int("workers", runtime.NumCPU(), "Number of salts tried in parallel")
+	fs.StringVar(&artifactsDir, "artifacts", "contracts/artifacts", "Directory holding the compiled artifacts")
+	fs.Parse(args)
+
+	prefix := strings.ToLower(strings.TrimPrefix(*prefixFlag, "0x"))
+	if prefix == "" {
+		fatal("The -prefix flag is required")
+	}
+	for i := range prefix {
+		if _, ok := hexNibble(prefix[i]); !ok || len(prefix) > common.AddressLength*2 {
+			fatalf("Invalid -prefix %q, expected up to 40 hex digits", *prefixFlag)
+		}
+	}
+	factory, err := parseAddress(*factoryFlag)
+	if err != nil {
+		fatalf("Invalid -factory address: %v", err)
+	}
+	if *maxAttempts == 0 || *workers < 1 {
+		fatal("The -max-attempts and -workers must be at least 1")
+	}
+
+	var codeHash common.Hash
+	if *initCodeHash != "" {
+		b, err := hexutil.Decode(*initCodeHash)
+		if err != nil || len(b) != common.HashLength {
+			fatalf("Invalid -init-code-hash %q, expected 32 bytes of 0x-prefixed hex", *initCodeHash)
+		}
+		codeHash = common.BytesToHash(b)
+	} else {
+		if *tokenName == "" || *tokenSymbol == "" || *totalSupply == "" {
+			fatal("Either -init-code-hash or all of -name, -symbol and -supply are required")
+		}
+		if codeHash, err = tokenInitCodeHash(*variantName, *tokenName, *tokenSymbol, *tokenDecimals, *totalSupply, *supplyCap); err != nil {
+			fatal(err)
+		}
+	}
+
+	var from common.Address
+	if *deployerFlag != "" {
+		if from, err = parseAddress(*deployerFlag); err != nil {
+			fatalf("Invalid -deployer address: %v", err)
+		}
+	} else {
+		account, err := loadSigner()
+		if err != nil {
+			fatalf("Failed to load account for -deployer: %v", err)
+		}
+		from = account.address
+		account.Close()
+	}
+
+	// Every hex digit of the prefix divides the chance of a salt matching by
+	// 16.
+	expected := math.Pow(16, float64(len(prefix)))
+	rate := saltMiningRate(factory, from, codeHash) * float64(*workers)
+	found := 1 - math.Exp(float64(*maxAttempts)*math.Log1p(-1/expected))
+	fmt.Printf("Prefix: 0x%s (%d hex digits, 1 in %.0f addresses match)\n", prefix, len(prefix), expected)
+	fmt.Printf("Expected time: %s at about %.0f salts per second on %d workers\n", formatSeconds(expected/rate), rate, *workers)
+	fmt.Printf("Chance of a match within %d attempts: %.1f%%\n", *maxAttempts, 100*found)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	start := time.Now()
+	salt, address, tried, ok := mineSalt(ctx, factory, from, codeHash, prefix, *maxAttempts, *workers)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if !ok {
+		if ctx.Err() != nil {
+			fatalf("Stopped after %d attempts in %s without a match", tried, elapsed)
+		}
+		fatalfCode(exitFailure, "No salt found in %d attempts (%s), raise -max-attempts or shorten the -prefix", tried, elapsed)
+	}
+	fmt.Printf("Salt: %s\n", hexutil.Encode(salt[:]))
+	fmt.Printf("Token address: %s\n", address.Hex())
+	fmt.Printf("Found after %d attempts in %s\n", tried, elapsed)
+	fmt.Printf("Deploy it from %s with: -create2 -salt %s", from.Hex(), hexutil.Encode(salt[:]))
+	if factory != defaultCreate2Factory() {
+		fmt.Printf(" -factory %s", factory.Hex())
+	}
+	fmt.Println()
+}
+
+// tokenInitCodeHash returns the keccak256 of the init code deploy -create2
+// sends for a token with the standard constructor, or the capped one.
+func tokenInitCodeHash(variantName, name, symbol string, decimals uint, supplyText, capText string) (common.Hash, error) {
+	variant, err := lookupVariant(variantName)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if variant == erc777Token || variant == taxToken || variant == wrappedToken {
+		return common.Hash{}, fmt.Errorf("the %s variant takes other constructor arguments, pass its -init-code-hash instead", variantName)
+	}
+	if decimals > 255 {
+		return common.Hash{}, fmt.Errorf("the -decimals must be at most 255")
+	}
+	supply, err := deployer.ParseSupply(supplyText, uint8(decimals))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to parse supply: %w", err)
+	}
+	ctorArgs := []interface{}{name, symbol, uint8(decimals), supply}
+	if variant == cappedToken {
+		cap := supply
+		if capText != "" {
+			if cap, err = deployer.ParseSupply(capText, uint8(decimals)); err != nil {
+				return common.Hash{}, fmt.Errorf("failed to parse cap: %w", err)
+			}
+		}
+		ctorArgs = append(ctorArgs, cap)
+	}
+	initCode, err := variant.DeployData(ctorArgs...)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode deployment data: %w", err)
+	}
+	return crypto.Keccak256Hash(initCode), nil
+}
+
+// saltMiner computes the addresses the factory deploys one init code to for
+// a given caller, reusing its buffers between salts.
+type saltMiner struct {
+	hasher  crypto.KeccakState
+	mixed   [64]byte // caller, salt
+	create2 [85]byte // 0xff, factory, mixed salt, init code hash
+	sum     [32]byte
+}
+
+func newSaltMiner(factory, from common.Address, codeHash common.Hash) *saltMiner {
+	m := &saltMiner{hasher: crypto.NewKeccakState()}
+	copy(m.mixed[12:32], from.Bytes())
+	m.create2[0] = 0xff
+	copy(m.create2[1:21], factory.Bytes())
+	copy(m.create2[53:], codeHash.Bytes())
+	return m
+}
+
+// address returns the token address for salt, the same as
+// predictCreate2Address.
+func (m *saltMiner) address(salt *[32]byte) common.Address {
+	copy(m.mixed[32:], salt[:])
+	m.hasher.Reset()
+	m.hasher.Write(m.mixed[:])
+	m.hasher.Read(m.create2[21:53])
+	m.hasher.Reset()
+	m.hasher.Write(m.create2[:])
+	m.hasher.Read(m.sum[:])
+	return common.BytesToAddress(m.sum[12:])
+}
+
+// saltFromCounter returns the salt numbered n. Salts are tried in order, so
+// the same search finds the same salt on any machine.
+func saltFromCounter(n uint64) [32]byte {
+	var salt [32]byte
+	binary.BigEndian.PutUint64(salt[24:], n)
+	return salt
+}
+
+// saltMiningRate measures how many salts one worker tries per second.
+func saltMiningRate(factory, from common.Address, codeHash common.Hash) float64 {
+	const samples = 20000
+	m := newSaltMiner(factory, from, codeHash)
+	start := time.Now()
+	for n := uint64(0); n < samples; n++ {
+		salt := saltFromCounter(n)
+		m.address(&salt)
+	}
+	return samples / max(time.Since(start).Seconds(), 1e-9)
+}
+
+// mineSalt tries the salts numbered 0 up to maxAttempts on workers
+// goroutines until the factory would deploy to an address starting with
+// prefix, and returns the lowest numbered matching salt, its address and the
+// number of salts tried. Salts are handed out in batches in order, so the
+// lowest match is the same however the batches are scheduled.
+func mineSalt(ctx context.Context, factory, from common.Address, codeHash common.Hash, prefix string, maxAttempts uint64, workers int) ([32]byte, common.Address, uint64, bool) {
+	const batch = 4096
+	nibbles := make([]byte, len(prefix))
+	for i := range prefix {
+		nibbles[i], _ = hexNibble(prefix[i])
+	}
+	var (
+		next  atomic.Uint64 // first salt of the next batch
+		tried atomic.Uint64
+		found atomic.Bool
+		mu    sync.Mutex
+		best  uint64
+		salt  [32]byte
+		addr  common.Address
+		wg    sync.WaitGroup
+	)
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				slog.Info("Mining salt", "tried", tried.Load(), "of", maxAttempts)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m := newSaltMiner(factory, from, codeHash)
+			for !found.Load() && ctx.Err() == nil {
+				first := next.Add(batch) - batch
+				if first >= maxAttempts {
+					return
+				}
+				last := min(first+batch, maxAttempts)
+				for n := first; n < last; n++ {
+					s := saltFromCounter(n)
+					a := m.address(&s)
+					if hasNibblePrefix(a, nibbles) {
+						mu.Lock()
+						if !found.Load() || n < best {
+							best, salt, addr = n, s, a
+						}
+						found.Store(true)
+						mu.Unlock()
+						tried.Add(n - first + 1)
+						return
+					}
+				}
+				tried.Add(last - first)
+			}
+		}()
+	}
+	wg.Wait()
+	close(done)
+	return salt, addr, tried.Load(), found.Load()
+}
+
+// hasNibblePrefix reports whether the hex digits of address start with
+// nibbles.
+func hasNibblePrefix(address common.Address, nibbles []byte) bool {
+	for i, want := range nibbles {
+		b := address[i/2]
+		if i%2 == 0 {
+			b >>= 4
+		}
+		if b&0x0f != want {
+			return false
+		}
+	}
+	return true
+}
+
+// hexNibble returns the value of the lowercase hex digit c.
+func hexNibble(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	}
+	return 0, false
+}
+
+// formatSeconds formats an estimated duration, which for long prefixes can
+// exceed what a time.Duration holds.
+func formatSeconds(seconds float64) string {
+	if seconds > 100*365*24*3600 {
+		return fmt.Sprintf("%.3g years", seconds/(365*24*3600))
+	}
+	d := time.Duration(seconds * float64(time.Second))
+	if d < time.Minute {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployMintableERC20Token deploys a new MintableERC20Token contract, owned by
+// the deploying account, and binds an instance of it.
+func DeployMintableERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *MintableERC20Token, error) {
+	address, tx, err := mintableToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewMintableERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runMint(args []string) {
+	fs := newFlagSet("mint")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -mintable")
+	to := fs.String("to", "", "Address receiving the new tokens")
+	amount := fs.String("amount", "", "Amount of tokens to mint (in whole units)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *to == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -to, -amount")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid recipient address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	decimals, err := tokenDecimals(ctx, client, common.HexToAddress(*contract))
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	instance, err := NewMintableERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "mint "+*amount+" tokens"); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.Mint(auth, common.HexToAddress(*to), value)
+	if err != nil {
+		fatal(txError("mint", err))
+	}
+	if _, err := broadcastAndWait(ctx, client, tx, "Mint"); err != nil {
+		fatalf("Mint failed: %v", err)
+	}
+}
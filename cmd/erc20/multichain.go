@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// parseNetworkList parses the -networks value into preset names, rejecting
+// unknown and repeated ones.
+func parseNetworkList(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var names []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ",") {
+		preset, err := lookupNetwork(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		if seen[preset.Name] {
+			return nil, fmt.Errorf("network %s is listed twice in -networks", preset.Name)
+		}
+		seen[preset.Name] = true
+		names = append(names, preset.Name)
+	}
+	return names, nil
+}
+
+// deployToNetworks deploys the planned token to each network in turn. A
+// failure on one network is reported and the next one is tried. Once all are
+// done a summary is printed, and false is returned if any deployment failed.
+func deployToNetworks(plan *deployPlan, account *signer, names []string) bool {
+	results := make([]*deployResult, 0, len(names))
+	ok := true
+	for _, name := range names {
+		fmt.Fprintf(progressWriter(plan.jsonOutput), "\n== %s ==\n", name)
+		// Every network gets its own client, so the nonce and fees are
+		// fetched per chain by createTransactor.
+		networkName = name
+		networkPlan := *plan
+		if plan.out != "" {
+			networkPlan.out = networkOutPath(plan.out, name)
+		}
+		result, err := deployToken(&networkPlan, account)
+		if result == nil {
+			result = &deployResult{SchemaVersion: deployResultSchemaVersion}
+		}
+		result.Network = name
+		if err != nil {
+			slog.Error("Deployment failed", "network", name, "err", err)
+			result.Error = err.Error()
+			ok = false
+		}
+		results = append(results, result)
+		// After Ctrl-C the remaining networks are skipped, but what was
+		// deployed so far is still reported.
+		if errors.Is(err, context.Canceled) {
+			break
+		}
+	}
+
+	if plan.jsonOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(results); err != nil {
+			fatalf("Failed to write JSON output: %v", err)
+		}
+		return ok
+	}
+	if plan.dryRun {
+		return ok
+	}
+
+	fmt.Printf("\nSummary:\n")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NETWORK\tADDRESS\tTRANSACTION\tGAS USED\tCOST\tSTATUS")
+	for _, r := range results {
+		status := "success"
+		if r.Error != "" {
+			status = "error: " + r.Error
+		}
+		gas, cost := "-", "-"
+		if r.TransactionHash != "" {
+			gas = fmt.Sprint(r.GasUsed)
+			cost = orDash(r.GasCost) + " " + networkCurrency(r.Network)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", r.Network, orDash(r.ContractAddress), orDash(r.TransactionHash), gas, cost, status)
+	}
+	w.Flush()
+	printGasReport(results)
+	return ok
+}
+
+// printGasReport prints the gas used and fees paid by all deployments that
+// were mined, successful or not, with the fees totalled per currency since
+// the networks may have different native currencies.
+func printGasReport(results []*deployResult) {
+	var gas uint64
+	mined := 0
+	costs := make(map[string]*big.Int)
+	var currencies []string
+	for _, r := range results {
+		if r.TransactionHash == "" {
+			continue
+		}
+		mined++
+		gas += r.GasUsed
+		cost, err := deployer.ParseUnits(r.GasCost, 18)
+		if err != nil {
+			continue
+		}
+		currency := networkCurrency(r.Network)
+		if costs[currency] == nil {
+			costs[currency] = new(big.Int)
+			currencies = append(currencies, currency)
+		}
+		costs[currency].Add(costs[currency], cost)
+	}
+	if mined == 0 {
+		return
+	}
+
+	totals := make([]string, len(currencies))
+	for i, currency := range currencies {
+		totals[i] = formatAmount(costs[currency], 18) + " " + currency
+	}
+	fmt.Printf("\nGas report:\n")
+	fmt.Printf("Deployments mined: %d of %d\n", mined, len(results))
+	fmt.Printf("Total gas used: %d\n", gas)
+	if len(totals) > 0 {
+		fmt.Printf("Total cost: %s\n", strings.Join(totals, ", "))
+	}
+}
+
+// networkCurrency returns the native currency of the named preset.
+func networkCurrency(name string) string {
+	preset, err := lookupNetwork(name)
+	if err != nil {
+		return "ETH"
+	}
+	return preset.currency()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
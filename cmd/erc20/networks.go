@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// network is a named chain preset selectable with -network.
+type network struct {
+	Name    string
+	RPC     string // default public RPC endpoint, overridden by -rpc
+	ChainID int64
+	Testnet bool
+	Faucet  string // default faucet endpoint for the faucet command, if any
+	Symbol  string // native currency, ETH if empty
+	// SafeService is the Safe Transaction Service that -safe proposals go
+	// to, if Safe runs one for the chain.
+	SafeService string
+}
+
+// currency returns the symbol of the native currency of n.
+func (n *network) currency() string {
+	if n.Symbol == "" {
+		return "ETH"
+	}
+	return n.Symbol
+}
+
+// networks lists the supported presets. Add new chains here.
+var networks = []network{
+	{Name: "mainnet", RPC: "https://ethereum-rpc.publicnode.com", ChainID: 1, SafeService: "https://safe-transaction-mainnet.safe.global"},
+	{Name: "sepolia", RPC: "https://ethereum-sepolia-rpc.publicnode.com", ChainID: 11155111, Testnet: true, SafeService: "https://safe-transaction-sepolia.safe.global"},
+	{Name: "holesky", RPC: "https://ethereum-holesky-rpc.publicnode.com", ChainID: 17000, Testnet: true},
+	{Name: "polygon", RPC: "https://polygon-rpc.com", ChainID: 137, Symbol: "POL", SafeService: "https://safe-transaction-polygon.safe.global"},
+	{Name: "base", RPC: "https://mainnet.base.org", ChainID: 8453, SafeService: "https://safe-transaction-base.safe.global"},
+	{Name: "arbitrum", RPC: "https://arb1.arbitrum.io/rpc", ChainID: 42161, SafeService: "https://safe-transaction-arbitrum.safe.global"},
+}
+
+// lookupNetwork returns the preset with the given name.
+func lookupNetwork(name string) (*network, error) {
+	for i := range networks {
+		if networks[i].Name == strings.ToLower(name) {
+			return &networks[i], nil
+		}
+	}
+	return nil, fmt.Errorf("unknown network %q, expected one of: %s", name, strings.Join(networkNames(), ", "))
+}
+
+// chainName returns the preset name for a chain ID, or "unknown".
+func chainName(chainID *big.Int) string {
+	for _, n := range networks {
+		if chainID.Cmp(big.NewInt(n.ChainID)) == 0 {
+			return n.Name
+		}
+	}
+	return "unknown"
+}
+
+func networkNames() []string {
+	names := make([]string, len(networks))
+	for i, n := range networks {
+		names[i] = n.Name
+	}
+	sort.Strings(names)
+	return names
+}
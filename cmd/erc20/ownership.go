@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func runTransferOwnership(args []string) {
+	fs := newFlagSet("transfer-ownership")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of an ownable token")
+	newOwner := fs.String("newowner", "", "Address of the new owner, e.g. a multisig")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *newOwner == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -newowner")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*newOwner); err != nil {
+		fatalf("Invalid new owner address: %v", err)
+	}
+	target := common.HexToAddress(*newOwner)
+	if target == (common.Address{}) {
+		fatal("The new owner must not be the zero address, use renounce-ownership to give up ownership")
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance := ownedBySigner(ctx, client, common.HexToAddress(*contract), account)
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "transfer ownership to "+target.Hex()); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.TransferOwnership(auth, target)
+	if err != nil {
+		fatal(txError("transfer ownership", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Ownership transfer")
+	if err != nil {
+		fatalf("Ownership transfer failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		owner, err := instance.Owner(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber})
+		if err != nil {
+			fatalf("Failed to query owner: %v", err)
+		}
+		fmt.Printf("Owner: %s\n", owner.Hex())
+		if owner != target {
+			fatalfCode(exitFailure, "The owner is %s, not the requested %s", owner.Hex(), target.Hex())
+		}
+	}
+}
+
+func runRenounceOwnership(args []string) {
+	fs := newFlagSet("renounce-ownership")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of an ownable token")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance := ownedBySigner(ctx, client, common.HexToAddress(*contract), account)
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "renounce ownership"); err != nil {
+		fatal(err)
+	}
+	if !assumeYes {
+		// Renouncing can't be undone, so ask for more than a y.
+		fmt.Fprintf(os.Stderr, "Renouncing ownership is IRREVERSIBLE: owner-only functions such as mint and pause\n")
+		fmt.Fprintf(os.Stderr, "can never be called again. Type 'renounce' to continue: ")
+		answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && answer == "" {
+			fatalf("Failed to read confirmation: %v", err)
+		}
+		if strings.TrimSpace(answer) != "renounce" {
+			fatalfCode(exitFailure, "aborted by user")
+		}
+	}
+
+	tx, err := instance.RenounceOwnership(auth)
+	if err != nil {
+		fatal(txError("renounce ownership", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Renouncement")
+	if err != nil {
+		fatalf("Renouncing ownership failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		owner, err := instance.Owner(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber})
+		if err != nil {
+			fatalf("Failed to query owner: %v", err)
+		}
+		fmt.Printf("Owner: %s\n", owner.Hex())
+	}
+}
+
+// ownedBySigner binds the Ownable interface of contract and checks that the
+// signing account owns it, so non-ownable tokens and foreign keys fail with a
+// clear message before anything is sent.
+func ownedBySigner(ctx context.Context, client bind.ContractBackend, contract common.Address, account *signer) *Ownable {
+	instance, err := NewOwnable(contract, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	owner, err := instance.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		fatalf("Contract %s is not ownable (owner() failed: %v), deploy with -mintable or -pausable for an owned token", contract.Hex(), err)
+	}
+	if owner == (common.Address{}) {
+		fatalf("Contract %s has no owner, ownership was renounced", contract.Hex())
+	}
+	if owner != account.address {
+		fatalf("Contract %s is owned by %s, not by the signing account %s", contract.Hex(), owner.Hex(), account.address.Hex())
+	}
+	return instance
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployPausableERC20Token deploys a new PausableERC20Token contract, owned by
+// the deploying account, and binds an instance of it.
+func DeployPausableERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *PausableERC20Token, error) {
+	address, tx, err := pausableToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewPausableERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runPause(args []string) {
+	setPaused("pause", args, true)
+}
+
+func runUnpause(args []string) {
+	setPaused("unpause", args, false)
+}
+
+// setPaused implements the pause and unpause commands, which only the owner
+// of a token deployed with -pausable may call.
+func setPaused(name string, args []string, pause bool) {
+	fs := newFlagSet(name)
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -pausable")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewPausableERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, name+" "+common.HexToAddress(*contract).Hex()); err != nil {
+		fatal(err)
+	}
+
+	var tx *types.Transaction
+	if pause {
+		tx, err = instance.Pause(auth)
+	} else {
+		tx, err = instance.Unpause(auth)
+	}
+	if err != nil {
+		fatal(txError(name, err))
+	}
+	label := "Pause"
+	if !pause {
+		label = "Unpause"
+	}
+	if _, err := broadcastAndWait(ctx, client, tx, label); err != nil {
+		fatalf("%s failed: %v", label, err)
+	}
+}
+
+func runStatus(args []string) {
+	fs := newFlagSet("status")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewPausableERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	fmt.Printf("Contract: %s\n", common.HexToAddress(*contract).Hex())
+	if symbol, err := readTokenString(opts, client, common.HexToAddress(*contract), "symbol"); err == nil {
+		fmt.Printf("Token symbol: %s\n", symbol)
+	}
+	if owner, err := instance.Owner(opts); err == nil {
+		fmt.Printf("Owner: %s\n", owner.Hex())
+	}
+
+	// Tokens deployed without -pausable have no paused() and can't be halted.
+	paused, err := instance.Paused(opts)
+	switch {
+	case err != nil:
+		fmt.Printf("Paused: not pausable\n")
+	case paused:
+		fmt.Printf("Paused: yes, transfers are halted\n")
+	default:
+		fmt.Printf("Paused: no\n")
+	}
+}
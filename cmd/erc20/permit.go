@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// DeployPermitERC20Token deploys a new PermitERC20Token contract, supporting
+// EIP-2612 gasless approvals, and binds an instance of it.
+func DeployPermitERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *PermitERC20Token, error) {
+	address, tx, err := permitToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewPermitERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runPermit(args []string) {
+	fs := newFlagSet("permit")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -permit")
+	spender := fs.String("spender", "", "Address allowed to spend the tokens")
+	amount := fs.String("amount", "", "Amount of tokens to approve (in whole units)")
+	deadline := fs.String("deadline", "1h", "Permit expiry, as a Unix timestamp or a duration from now")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *spender == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -spender, -amount")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*spender); err != nil {
+		fatalf("Invalid spender address: %v", err)
+	}
+	expiry, err := parseDeadline(*deadline, time.Now())
+	if err != nil {
+		fatalf("Invalid -deadline: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+	if account.key == nil {
+		fatal("Signing a permit requires a private key, -ledger and -signer-url are not supported")
+	}
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	// The chain judges the deadline by block time, which may lag the local clock.
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		fatalf("Failed to read latest block: %v", err)
+	}
+	if expiry.Uint64() <= head.Time {
+		fatalf("The -deadline %s is not after the latest block time %s", expiry, time.Unix(int64(head.Time), 0).UTC().Format(time.RFC3339))
+	}
+
+	instance, err := NewPermitERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	decimals, err := instance.Decimals(opts)
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	v, r, s, err := signPermit(ctx, client, instance, common.HexToAddress(*contract), account, common.HexToAddress(*spender), value, expiry)
+	if err != nil {
+		fatalf("Failed to sign permit: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "permit "+*amount+" tokens"); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.Permit(auth, account.address, common.HexToAddress(*spender), value, expiry, v, r, s)
+	if err != nil {
+		if reason, ok := revertReason(err); ok && strings.HasPrefix(reason, "ERC2612ExpiredSignature") {
+			fatalfCode(exitFailure, "Permit signature expired: the deadline %s has passed on-chain", time.Unix(expiry.Int64(), 0).UTC().Format(time.RFC3339))
+		}
+		fatal(txError("permit", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Permit")
+	if err != nil {
+		fatalf("Permit failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		allowance, err := instance.Allowance(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, account.address, common.HexToAddress(*spender))
+		if err != nil {
+			fatalf("Failed to query allowance: %v", err)
+		}
+		fmt.Printf("Allowance: %s\n", formatAllowance(allowance, decimals))
+	}
+}
+
+// parseDeadline parses a permit deadline given either as a Unix timestamp or
+// as a duration relative to now, and rejects deadlines that already passed.
+func parseDeadline(value string, now time.Time) (*big.Int, error) {
+	var expiry int64
+	if ts, err := strconv.ParseInt(value, 10, 64); err == nil {
+		expiry = ts
+	} else if d, err := time.ParseDuration(value); err == nil {
+		expiry = now.Add(d).Unix()
+	} else {
+		return nil, fmt.Errorf("%q is neither a Unix timestamp nor a duration", value)
+	}
+	if expiry <= now.Unix() {
+		return nil, fmt.Errorf("%s is not in the future", time.Unix(expiry, 0).UTC().Format(time.RFC3339))
+	}
+	return big.NewInt(expiry), nil
+}
+
+// signPermit signs the EIP-2612 Permit typed data allowing spender to spend
+// value of the account's tokens until deadline. The EIP-712 domain is built
+// from the token name, chain ID and contract address, and checked against the
+// contract's DOMAIN_SEPARATOR so a mismatch fails here rather than on-chain.
+func signPermit(ctx context.Context, client *ethclient.Client, instance *PermitERC20Token, contract common.Address, account *signer, spender common.Address, value, deadline *big.Int) (uint8, [32]byte, [32]byte, error) {
+	var r, s [32]byte
+
+	opts := &bind.CallOpts{Context: ctx}
+	name, err := instance.Name(opts)
+	if err != nil {
+		return 0, r, s, fmt.Errorf("failed to query name: %w", err)
+	}
+	nonce, err := instance.Nonces(opts, account.address)
+	if err != nil {
+		return 0, r, s, fmt.Errorf("failed to query permit nonce, is the token deployed with -permit? %w", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return 0, r, s, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: contract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"owner":    account.address.Hex(),
+			"spender":  spender.Hex(),
+			"value":    value.String(),
+			"nonce":    nonce.String(),
+			"deadline": deadline.String(),
+		},
+	}
+
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
+	if err != nil {
+		return 0, r, s, err
+	}
+	onchain, err := instance.DOMAINSEPARATOR(opts)
+	if err != nil {
+		return 0, r, s, fmt.Errorf("failed to query DOMAIN_SEPARATOR: %w", err)
+	}
+	if common.BytesToHash(domainSeparator) != common.Hash(onchain) {
+		return 0, r, s, fmt.Errorf("computed EIP-712 domain separator %s does not match the contract's %s", common.BytesToHash(domainSeparator).Hex(), common.Hash(onchain).Hex())
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return 0, r, s, err
+	}
+	sig, err := crypto.Sign(hash, account.key)
+	if err != nil {
+		return 0, r, s, err
+	}
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+	return sig[64] + 27, r, s, nil
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// loadSigners loads the accounts serve deploys from: every file of
+// keystores, decrypted with one passphrase, or count accounts derived from
+// the mnemonic at consecutive indexes from -hdpath, or else the single
+// account of loadSigner.
+func loadSigners(keystores []string, count int) ([]*signer, error) {
+	if len(keystores) > 0 {
+		if privateKey != "" || keystorePath != "" || mnemonic != "" || useLedger || signerURL != "" {
+			return nil, fmt.Errorf("-keystores cannot be combined with -key, -keystore, -mnemonic, -ledger or -signer-url")
+		}
+		return decryptKeystores(keystores)
+	}
+	if count > 1 {
+		return deriveMnemonicSigners(count)
+	}
+	account, err := loadSigner()
+	if err != nil {
+		return nil, err
+	}
+	return []*signer{account}, nil
+}
+
+// decryptKeystores decrypts every keystore file with the -passphrase value or
+// a passphrase prompted for once.
+func decryptKeystores(paths []string) ([]*signer, error) {
+	pass := []byte(passphrase)
+	passphrase = ""
+	if len(pass) == 0 {
+		pass = promptForPassphrase()
+	}
+	defer func() {
+		for i := range pass {
+			pass[i] = 0
+		}
+	}()
+
+	signers := make([]*signer, 0, len(paths))
+	for _, path := range paths {
+		keyJSON, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read keystore: %w", err)
+		}
+		key, err := keystore.DecryptKey(keyJSON, string(pass))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt keystore %s: %w", path, err)
+		}
+		signers = append(signers, keySigner(key.PrivateKey))
+	}
+	return signers, nil
+}
+
+// deriveMnemonicSigners derives count accounts from the mnemonic given by
+// -mnemonic or $TOKKEN_MNEMONIC, starting at -hdpath and incrementing its
+// last index.
+func deriveMnemonicSigners(count int) ([]*signer, error) {
+	phrase := mnemonic
+	if phrase == "" {
+		phrase = os.Getenv(mnemonicEnv)
+	}
+	if phrase == "" || privateKey != "" || keystorePath != "" || useLedger || signerURL != "" {
+		return nil, fmt.Errorf("-accounts needs a mnemonic from -mnemonic or $%s", mnemonicEnv)
+	}
+	path, err := accounts.ParseDerivationPath(hdPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid HD path %q: %w", hdPath, err)
+	}
+
+	signers := make([]*signer, 0, count)
+	for i := 0; i < count; i++ {
+		key, err := deriveMnemonicKey(phrase, path.String())
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, keySigner(key))
+		path[len(path)-1]++
+	}
+	return signers, nil
+}
+
+func keySigner(key *ecdsa.PrivateKey) *signer {
+	return &signer{address: crypto.PubkeyToAddress(key.PublicKey), key: key}
+}
+
+// accountPool rotates deployments on one chain among several accounts, so
+// each account signs and sends independently of the others.
+type accountPool struct {
+	accounts []*pooledAccount
+	next     atomic.Uint64
+}
+
+func newAccountPool(signers []*signer) *accountPool {
+	p := &accountPool{}
+	for _, s := range signers {
+		p.accounts = append(p.accounts, &pooledAccount{signer: s})
+	}
+	return p
+}
+
+// pick returns the accounts in turn.
+func (p *accountPool) pick() *pooledAccount {
+	return p.accounts[(p.next.Add(1)-1)%uint64(len(p.accounts))]
+}
+
+// pooledAccount is an account of a pool together with its next nonce on the
+// chain, tracked locally so back-to-back deployments don't depend on the node
+// having seen the previous one yet.
+type pooledAccount struct {
+	signer *signer
+
+	// mu is held while a deployment is signed and sent, and guards the
+	// fields below.
+	mu sync.Mutex
+	// nonce is the next nonce of the account, valid once synced.
+	nonce  uint64
+	synced bool
+	// inFlight counts the deployments sent but not mined yet.
+	inFlight int
+}
+
+// reserve returns the nonce of the next transaction, fetching it from the
+// node if it is not known. The caller holds a.mu and calls sent or failed
+// once the transaction was sent or could not be.
+func (a *pooledAccount) reserve(ctx context.Context, client *ethclient.Client) (uint64, error) {
+	if !a.synced {
+		pending, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+			return client.PendingNonceAt(ctx, a.signer.address)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		a.nonce, a.synced = pending, true
+	}
+	return a.nonce, nil
+}
+
+// sent records that the reserved nonce was used by a transaction.
+func (a *pooledAccount) sent() {
+	a.nonce++
+	a.inFlight++
+}
+
+// failed forgets the local nonce after a failed send, e.g. with "nonce too
+// low", so the next deployment starts from the node's value.
+func (a *pooledAccount) failed() {
+	a.synced = false
+}
+
+// mined records that a deployment sent from the account is no longer
+// pending.
+func (a *pooledAccount) mined() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inFlight--
+}
+
+// reconcile compares the local nonces of the pool with the node every
+// interval until ctx ends. A higher pending nonce means the account was used
+// elsewhere, and a lower one with nothing in flight that transactions were
+// dropped; in both cases the node's value is taken.
+func (p *accountPool) reconcile(ctx context.Context, network string, client *ethclient.Client, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, a := range p.accounts {
+			a.mu.Lock()
+			if a.synced {
+				pending, err := client.PendingNonceAt(ctx, a.signer.address)
+				switch {
+				case err != nil:
+					slog.Debug("Failed to reconcile nonce", "network", network, "account", a.signer.address.Hex(), "err", err)
+				case pending > a.nonce:
+					slog.Warn("Account was used outside the server, skipping to its pending nonce", "network", network, "account", a.signer.address.Hex(), "local", a.nonce, "pending", pending)
+					a.nonce = pending
+				case pending < a.nonce && a.inFlight == 0:
+					slog.Warn("Transactions of the account were dropped, going back to its pending nonce", "network", network, "account", a.signer.address.Hex(), "local", a.nonce, "pending", pending)
+					a.nonce = pending
+				}
+			}
+			a.mu.Unlock()
+		}
+	}
+}
+
+// addresses returns the addresses of signers, comma-separated.
+func addresses(signers []*signer) string {
+	hexes := make([]string, len(signers))
+	for i, s := range signers {
+		hexes[i] = s.address.Hex()
+	}
+	return strings.Join(hexes, ", ")
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func runPreflight(args []string) {
+	fs := newFlagSet("preflight")
+	addRPCFlag(fs)
+	maxAge := fs.Duration("max-age", 2*time.Minute, "Fail if the latest block is older than this, e.g. on a node that is still syncing")
+	fs.Parse(args)
+
+	if rpcURL == "" && networkName == "" {
+		fatal("One of -rpc or -network is required")
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	// Dialing HTTP endpoints does not send anything, so the first request
+	// is what shows whether the node is reachable.
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		fatalf("RPC endpoint is unreachable: %v", err)
+	}
+	fmt.Printf("Chain ID: %s (%s)\n", chainID, chainName(chainID))
+
+	var version string
+	if err := client.Client().CallContext(ctx, &version, "web3_clientVersion"); err != nil {
+		slog.Warn("Failed to get the client version", "err", err)
+		version = unavailable
+	}
+	fmt.Printf("Client version: %s\n", version)
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		fatalf("Failed to get the latest block: %v", err)
+	}
+	age := time.Since(time.Unix(int64(header.Time), 0)).Truncate(time.Second)
+	fmt.Printf("Latest block: %s (%s old)\n", header.Number, age)
+
+	if header.BaseFee != nil {
+		fmt.Printf("EIP-1559: supported, base fee %s Gwei\n", formatAmount(header.BaseFee, 9))
+	} else {
+		fmt.Printf("EIP-1559: not supported, transactions use legacy gas prices\n")
+	}
+	// -fee-strategy history depends on eth_feeHistory.
+	if _, err := client.FeeHistory(ctx, 1, nil, []float64{50}); err != nil {
+		fmt.Printf("eth_feeHistory: not supported (%v)\n", err)
+	} else {
+		fmt.Printf("eth_feeHistory: supported\n")
+	}
+	if client.Client().SupportsSubscriptions() {
+		fmt.Printf("Subscriptions: supported\n")
+	}
+
+	if *maxAge > 0 && age > *maxAge {
+		fatalfCode(exitNetwork, "Latest block is %s old, more than -max-age %s: the node may be stale or still syncing", age, *maxAge)
+	}
+	fmt.Printf("\nRPC endpoint is healthy.\n")
+}
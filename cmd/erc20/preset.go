@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// tokenPreset is a -preset: deploy flag defaults for a common kind of token.
+type tokenPreset struct {
+	name        string
+	description string            // shown in the -preset help
+	flags       map[string]string // token flag values
+	// variant is the variant flag the preset turns on, unless another
+	// variant is asked for explicitly.
+	variant string
+	// wholeSupply rejects a supply with a fractional part.
+	wholeSupply bool
+}
+
+var tokenPresets = []tokenPreset{
+	{
+		name:        "stablecoin",
+		description: "6 decimals like USDC and USDT, supply in whole units",
+		flags:       map[string]string{"decimals": "6"},
+		wholeSupply: true,
+	},
+	{
+		name:        "governance",
+		description: "the -votes variant with 18 decimals",
+		flags:       map[string]string{"decimals": "18"},
+		variant:     "votes",
+	},
+}
+
+// variantFlags are the deploy flags choosing what kind of token is deployed.
+var variantFlags = []string{"mintable", "burnable", "pausable", "permit", "votes", "erc777", "erc1363", "restricted", "wrapped", "tax-bps", "clone-of", "upgradeable"}
+
+// presetUsage describes the presets for the -preset help.
+func presetUsage() string {
+	descriptions := make([]string, len(tokenPresets))
+	for i, p := range tokenPresets {
+		descriptions[i] = p.name + " (" + p.description + ")"
+	}
+	return "Defaults for a common kind of token, each overridden by flags given explicitly: " + strings.Join(descriptions, "; ")
+}
+
+// applyPreset fills the deploy flags not set on the command line, by -config
+// or by -metadata from the preset called name.
+func applyPreset(fs *flag.FlagSet, name string) (*tokenPreset, error) {
+	var preset *tokenPreset
+	names := make([]string, len(tokenPresets))
+	for i := range tokenPresets {
+		names[i] = tokenPresets[i].name
+		if tokenPresets[i].name == name {
+			preset = &tokenPresets[i]
+		}
+	}
+	if preset == nil {
+		return nil, fmt.Errorf("unknown -preset %q, expected %s", name, strings.Join(names, " or "))
+	}
+
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	for flagName, value := range preset.flags {
+		if explicit[flagName] {
+			continue
+		}
+		if err := fs.Set(flagName, value); err != nil {
+			return nil, err
+		}
+	}
+	if preset.variant != "" {
+		for _, flagName := range variantFlags {
+			if explicit[flagName] {
+				return preset, nil
+			}
+		}
+		if err := fs.Set(preset.variant, "true"); err != nil {
+			return nil, err
+		}
+	}
+	return preset, nil
+}
+
+// checkWholeSupply rejects a supply, in base units, with a fractional part.
+func checkWholeSupply(supply *big.Int, decimals uint8, preset string) error {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	if new(big.Int).Mod(supply, unit).Sign() != 0 {
+		return fmt.Errorf("the %s preset takes a supply in whole units, got %s", preset, formatAmount(supply, decimals))
+	}
+	return nil
+}
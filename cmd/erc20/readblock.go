@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// readBlock is the flag.Value behind -block on read commands: a block
+// number, or one of the tags latest, pending, safe and finalized.
+type readBlock struct {
+	tag    string
+	number *big.Int // set when a block number is given
+}
+
+func (b *readBlock) String() string {
+	if b.number != nil {
+		return b.number.String()
+	}
+	return b.tag
+}
+
+func (b *readBlock) Set(value string) error {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "latest", "pending", "safe", "finalized":
+		b.tag, b.number = value, nil
+		return nil
+	}
+	number, ok := new(big.Int).SetString(value, 0)
+	if !ok || number.Sign() < 0 || !number.IsUint64() {
+		return fmt.Errorf("invalid block %q, expected a block number or latest, pending, safe or finalized", value)
+	}
+	b.tag, b.number = "", number
+	return nil
+}
+
+// addBlockFlag registers -block on a read command, defaulting to latest.
+func addBlockFlag(fs *flag.FlagSet) *readBlock {
+	block := &readBlock{tag: "latest"}
+	fs.Var(block, "block", "Block to read at: a number, latest, pending, safe or finalized")
+	return block
+}
+
+// callOpts returns the options to read at the block. A tag other than
+// pending is resolved to its number once, so that every call of the
+// command reads the same state even when a load-balanced endpoint answers
+// from nodes at different heights.
+func (b *readBlock) callOpts(ctx context.Context, client *ethclient.Client) (*bind.CallOpts, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	switch b.tag {
+	case "":
+		opts.BlockNumber = b.number
+	case "pending":
+		opts.Pending = true
+	case "latest":
+		number, err := withRetry(ctx, "get block number", func() (uint64, error) {
+			return client.BlockNumber(ctx)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the latest block: %w", err)
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(number)
+	default:
+		tag := rpc.FinalizedBlockNumber
+		if b.tag == "safe" {
+			tag = rpc.SafeBlockNumber
+		}
+		header, err := withRetry(ctx, "get "+b.tag+" block", func() (*types.Header, error) {
+			return client.HeaderByNumber(ctx, big.NewInt(int64(tag)))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the %s block, the network may not support it: %w", b.tag, err)
+		}
+		opts.BlockNumber = header.Number
+	}
+	return opts, nil
+}
+
+// describeBlock names the block opts read at.
+func describeBlock(opts *bind.CallOpts) string {
+	if opts.Pending {
+		return "pending"
+	}
+	return opts.BlockNumber.String()
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func runRecover(args []string) {
+	fs := newFlagSet("recover")
+	addRPCFlag(fs)
+	sigFlag := fs.String("sig", "", "65-byte signature of -message, as returned by personal_sign or eth_sign")
+	message := fs.String("message", "", "Text that was signed with EIP-191 personal_sign")
+	txFlag := fs.String("tx", "", "Signed raw transaction, or the hash of a broadcast one to fetch with -rpc")
+	fs.Parse(args)
+
+	switch {
+	case *txFlag != "" && (*sigFlag != "" || *message != ""):
+		fatal("Give either -tx, or -sig and -message")
+	case *txFlag != "":
+		recoverTransactionSender(*txFlag)
+	case *sigFlag != "":
+		signer, err := recoverMessageSigner(*sigFlag, *message)
+		if err != nil {
+			fatal(err)
+		}
+		fmt.Printf("Signer: %s\n", signer.Hex())
+	default:
+		fatal("One of -tx, or -sig and -message, is required")
+	}
+}
+
+// recoverMessageSigner returns the address whose key signed message with
+// personal_sign, which prefixes it as EIP-191 specifies before hashing.
+func recoverMessageSigner(sigHex, message string) (common.Address, error) {
+	sig, err := hexutil.Decode(sigHex)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid -sig, expected %d bytes of 0x-prefixed hex", crypto.SignatureLength)
+	}
+	// Wallets return v as 27 or 28, SigToPub expects the recovery ID.
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(accounts.TextHash([]byte(message)), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// recoverTransactionSender prints the sender of a raw transaction, or of the
+// transaction with the given hash on the -rpc network.
+func recoverTransactionSender(value string) {
+	data, err := hexutil.Decode(value)
+	if err != nil {
+		fatalf("Invalid -tx, expected 0x-prefixed hex: %v", err)
+	}
+
+	tx := new(types.Transaction)
+	if len(data) == common.HashLength {
+		if rpcURL == "" && networkName == "" {
+			fatal("One of -rpc or -network is required to look up a transaction by hash")
+		}
+		ctx, cancel := commandContext()
+		defer cancel()
+
+		client, err := dialClient(ctx)
+		if err != nil {
+			fatalf("Failed to connect to the Ethereum network: %v", err)
+		}
+		defer client.Close()
+
+		hash := common.BytesToHash(data)
+		tx, _, err = client.TransactionByHash(ctx, hash)
+		if errors.Is(err, ethereum.NotFound) {
+			fatalf("Transaction %s not found on this network", hash.Hex())
+		}
+		if err != nil {
+			fatalf("Failed to get transaction: %v", err)
+		}
+	} else if err := tx.UnmarshalBinary(data); err != nil {
+		fatalf("Invalid -tx, neither a transaction hash nor a signed raw transaction: %v", err)
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		fatalf("Failed to recover the sender: %v", err)
+	}
+	fmt.Printf("Transaction hash: %s\n", tx.Hash().Hex())
+	if tx.Protected() {
+		fmt.Printf("Chain ID: %s\n", tx.ChainId())
+	} else {
+		fmt.Println("Chain ID: none, the transaction has no EIP-155 replay protection")
+	}
+	fmt.Printf("Signer: %s\n", from.Hex())
+}
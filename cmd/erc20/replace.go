@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// minReplacementBump is the fee increase, in percent, that geth and most
+// other nodes require before accepting a transaction replacing a pending one.
+const minReplacementBump = 10
+
+func runSpeedup(args []string) {
+	fs := newFlagSet("speedup")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	txFlag := fs.String("tx", "", "Hash of the pending transaction to speed up")
+	bump := fs.Uint64("bump", minReplacementBump, "Percentage to raise the gas price, or the fee cap and priority fee, by")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *txFlag == "" {
+		fatal("All flags are required: -rpc (or -network), -tx")
+	}
+	hash, err := parseTxHash(*txFlag)
+	if err != nil {
+		fatal(err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	stuck, err := pendingTransaction(ctx, client, hash, account.address)
+	if err != nil {
+		fatal(err)
+	}
+	replacement, err := replaceTransaction(ctx, client, account, stuck, stuck.To(), stuck.Value(), stuck.Data(), stuck.Gas(), *bump)
+	if err != nil {
+		fatal(err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, fmt.Sprintf("replace transaction %s with a %d%% higher fee", hash.Hex(), *bump)); err != nil {
+		fatal(err)
+	}
+	fmt.Printf("Original transaction: %s\n", hash.Hex())
+	if _, err := broadcastAndWait(ctx, client, replacement, "Speed-up"); err != nil {
+		fatalf("Speed-up failed: %v", err)
+	}
+}
+
+func runCancel(args []string) {
+	fs := newFlagSet("cancel")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	txFlag := fs.String("tx", "", "Hash of the pending transaction to cancel")
+	bump := fs.Uint64("bump", minReplacementBump, "Percentage to raise the gas price, or the fee cap and priority fee, by")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *txFlag == "" {
+		fatal("All flags are required: -rpc (or -network), -tx")
+	}
+	hash, err := parseTxHash(*txFlag)
+	if err != nil {
+		fatal(err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	stuck, err := pendingTransaction(ctx, client, hash, account.address)
+	if err != nil {
+		fatal(err)
+	}
+	// The node may still list a transaction as pending for a moment after
+	// another one with its nonce was mined.
+	mined, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+		return client.NonceAt(ctx, account.address, nil)
+	})
+	if err != nil {
+		fatalf("Failed to get nonce: %v", err)
+	}
+	if stuck.Nonce() < mined {
+		fatalfCode(exitFailure, "Nonce %d of transaction %s is already used by a mined transaction", stuck.Nonce(), hash.Hex())
+	}
+
+	// Cancelling replaces the transaction with a plain transfer of nothing
+	// to the signer itself, which costs the minimum gas.
+	cancellation, err := replaceTransaction(ctx, client, account, stuck, &account.address, new(big.Int), nil, params.TxGas, *bump)
+	if err != nil {
+		fatal(err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, fmt.Sprintf("cancel transaction %s at nonce %d", hash.Hex(), stuck.Nonce())); err != nil {
+		fatal(err)
+	}
+	fmt.Printf("Original transaction: %s\n", hash.Hex())
+	receipt, err := broadcastAndWait(ctx, client, cancellation, "Cancellation")
+	if err != nil {
+		fatalf("Cancellation failed: %v", err)
+	}
+	if receipt.Status == 1 {
+		fmt.Printf("Transaction %s is cancelled, nonce %d is used by the cancellation.\n", hash.Hex(), stuck.Nonce())
+	}
+}
+
+// parseTxHash parses a 0x-prefixed 32 byte transaction hash.
+func parseTxHash(s string) (common.Hash, error) {
+	if b, err := hexutil.Decode(s); err != nil || len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid transaction hash: %s", s)
+	}
+	return common.HexToHash(s), nil
+}
+
+// pendingTransaction looks up the transaction with the given hash and makes
+// sure it can still be replaced: it is not mined yet and was sent by from.
+func pendingTransaction(ctx context.Context, client *ethclient.Client, hash common.Hash, from common.Address) (*types.Transaction, error) {
+	tx, pending, err := client.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("transaction %s not found: this node has never seen it or it was dropped", hash.Hex())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !pending {
+		if receipt, err := client.TransactionReceipt(ctx, hash); err == nil {
+			return nil, fmt.Errorf("transaction %s is already mined in block %s and can no longer be replaced", hash.Hex(), receipt.BlockNumber)
+		}
+		return nil, fmt.Errorf("transaction %s is already mined and can no longer be replaced", hash.Hex())
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover the sender: %w", err)
+	}
+	if sender != from {
+		return nil, fmt.Errorf("transaction %s was sent by %s, not by the signing account %s", hash.Hex(), sender.Hex(), from.Hex())
+	}
+	return tx, nil
+}
+
+// replaceTransaction signs a transaction with the nonce and type of stuck but
+// the given contents, paying bump percent more than stuck for gas so that
+// nodes accept it as a replacement.
+func replaceTransaction(ctx context.Context, client *ethclient.Client, account *signer, stuck *types.Transaction, to *common.Address, value *big.Int, data []byte, gas uint64, bump uint64) (*types.Transaction, error) {
+	if bump == 0 {
+		return nil, fmt.Errorf("-bump must be above 0")
+	}
+	if bump < minReplacementBump {
+		slog.Warn("Most nodes reject replacements paying less than 10% more", "bump", bump)
+	}
+	raise := func(fee *big.Int) *big.Int {
+		fee = new(big.Int).Mul(fee, new(big.Int).SetUint64(100+bump))
+		// Rounding up keeps tiny fees from not moving at all.
+		return fee.Add(fee, big.NewInt(99)).Div(fee, big.NewInt(100))
+	}
+
+	// A call without data has no use for the access list, whose gas would
+	// not fit the limit of a plain transfer.
+	accessList := stuck.AccessList()
+	if len(data) == 0 {
+		accessList = nil
+	}
+
+	var inner types.TxData
+	switch stuck.Type() {
+	case types.LegacyTxType:
+		inner = &types.LegacyTx{Nonce: stuck.Nonce(), GasPrice: raise(stuck.GasPrice()), Gas: gas, To: to, Value: value, Data: data}
+	case types.AccessListTxType:
+		inner = &types.AccessListTx{ChainID: stuck.ChainId(), Nonce: stuck.Nonce(), GasPrice: raise(stuck.GasPrice()), Gas: gas, To: to, Value: value, Data: data, AccessList: accessList}
+	case types.DynamicFeeTxType:
+		inner = &types.DynamicFeeTx{ChainID: stuck.ChainId(), Nonce: stuck.Nonce(), GasTipCap: raise(stuck.GasTipCap()), GasFeeCap: raise(stuck.GasFeeCap()), Gas: gas, To: to, Value: value, Data: data, AccessList: accessList}
+	default:
+		return nil, fmt.Errorf("cannot replace transactions of type %d", stuck.Type())
+	}
+
+	chainID, err := withRetry(ctx, "get chain ID", func() (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	auth, err := account.transactOpts(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transactor: %w", err)
+	}
+	tx, err := auth.Signer(account.address, types.NewTx(inner))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign replacement: %w", err)
+	}
+	// GasFeeCap is the gas price of legacy transactions.
+	if err := checkGasFunds(ctx, client, &bind.TransactOpts{From: account.address, GasLimit: gas, GasPrice: tx.GasFeeCap(), Value: value}); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployRestrictedERC20Token deploys a new RestrictedERC20Token contract,
+// whose owner, the deploying account, can block addresses, and binds an
+// instance of it.
+func DeployRestrictedERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *RestrictedERC20Token, error) {
+	address, tx, err := restrictedToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewRestrictedERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runBlockAddress(args []string) {
+	setBlocked("block-address", args, true)
+}
+
+func runUnblockAddress(args []string) {
+	setBlocked("unblock-address", args, false)
+}
+
+// setBlocked implements the block-address and unblock-address commands,
+// which only the owner of a token deployed with -restricted may call.
+func setBlocked(name string, args []string, block bool) {
+	verb, label := "block", "Block"
+	if !block {
+		verb, label = "unblock", "Unblock"
+	}
+	fs := newFlagSet(name)
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -restricted")
+	account := fs.String("address", "", "Address to "+verb)
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *account == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -address")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	target, err := parseAddress(*account)
+	if err != nil {
+		fatalf("Invalid address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	signer, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer signer.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewRestrictedERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	// Tokens deployed without -restricted have no isBlocked(), and blocking
+	// an address twice only wastes gas.
+	blocked, err := instance.IsBlocked(&bind.CallOpts{Context: ctx}, target)
+	if err != nil {
+		fatalf("Token %s has no denylist, it was not deployed with -restricted", common.HexToAddress(*contract).Hex())
+	}
+	if blocked == block {
+		fmt.Printf("%s is already %s, nothing to do.\n", target.Hex(), blockedState(blocked))
+		return
+	}
+
+	auth, err := createTransactor(ctx, signer, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, verb+" "+target.Hex()); err != nil {
+		fatal(err)
+	}
+
+	var tx *types.Transaction
+	if block {
+		tx, err = instance.BlockAddress(auth, target)
+	} else {
+		tx, err = instance.UnblockAddress(auth, target)
+	}
+	if err != nil {
+		fatal(txError(verb+" address", err))
+	}
+	if _, err := broadcastAndWait(ctx, client, tx, label); err != nil {
+		fatalf("%s failed: %v", label, err)
+	}
+	fmt.Printf("%s is now %s.\n", target.Hex(), blockedState(block))
+}
+
+func runIsBlocked(args []string) {
+	fs := newFlagSet("is-blocked")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -restricted")
+	account := fs.String("address", "", "Address to check")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *account == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -address")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	target, err := parseAddress(*account)
+	if err != nil {
+		fatalf("Invalid address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewRestrictedERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	blocked, err := instance.IsBlocked(&bind.CallOpts{Context: ctx}, target)
+	if err != nil {
+		fatalf("Token %s has no denylist, it was not deployed with -restricted", common.HexToAddress(*contract).Hex())
+	}
+	fmt.Printf("%s: %s\n", target.Hex(), blockedState(blocked))
+}
+
+// blockedState describes whether an address is blocked.
+func blockedState(blocked bool) string {
+	if blocked {
+		return "blocked, it can neither send nor receive tokens"
+	}
+	return "not blocked"
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	retries    int
+	retryDelay time.Duration
+)
+
+// withRetry calls fn until it succeeds, fails with an error that is not
+// transient, or -retries retries have been made. The delay between attempts
+// starts at -retry-delay and doubles each time, with up to 50% jitter added.
+func withRetry[T any](ctx context.Context, op string, fn func() (T, error)) (T, error) {
+	delay := retryDelay
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		result, err := fn()
+		slog.Debug("RPC call", "op", op, "attempt", attempt, "duration", time.Since(start).Round(time.Microsecond), "err", err)
+		if err == nil {
+			return result, nil
+		}
+		if !isTransient(err) {
+			return result, err
+		}
+		if attempt > retries {
+			return result, fmt.Errorf("%s failed after %d attempts: %w", op, attempt, err)
+		}
+
+		wait := delay
+		if delay > 0 {
+			wait += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+		}
+		slog.Warn("Transient RPC failure, retrying", "op", op, "attempt", attempt, "of", retries+1, "wait", wait.Round(time.Millisecond), "err", err)
+		if err := sleepContext(ctx, wait); err != nil {
+			return result, fmt.Errorf("%s failed after %d attempts: %w", op, attempt, err)
+		}
+		delay *= 2
+	}
+}
+
+// retryCall is withRetry for requests without a result, in the shape of
+// deployer.TransactorConfig.Retry.
+func retryCall(ctx context.Context, op string, fn func() error) error {
+	_, err := withRetry(ctx, op, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// isTransient reports whether err is likely to go away on retry, such as a
+// timeout, a dropped connection or a rate limit. Node-side rejections like
+// reverts, bad nonces or insufficient funds are not transient.
+func isTransient(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, transient := range []string{"rate limit", "too many requests", "timeout", "connection reset", "connection refused", "eof", "header not found"} {
+		if strings.Contains(msg, transient) {
+			return true
+		}
+	}
+	return false
+}
+
+// sleepContext pauses for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
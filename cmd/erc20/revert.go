@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// revertReason extracts a human-readable revert reason from an error returned
+// by the node, e.g. during gas estimation. It returns false if the error does
+// not carry revert data.
+func revertReason(err error) (string, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return "", false
+	}
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return "", false
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil || len(data) == 0 {
+		return "", false
+	}
+	return decodeRevert(data), true
+}
+
+// receiptRevertReason explains why the mined transaction tx failed. Receipts
+// carry no revert data, so the transaction is replayed as a call at the block
+// it was mined in and the revert data of that call is decoded. The replay
+// runs on the state after the block, so when it no longer fails, e.g. because
+// a later transaction in the block changed the state, the reason is unknown.
+func receiptRevertReason(ctx context.Context, client *ethclient.Client, tx *types.Transaction, receipt *types.Receipt) string {
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return fmt.Sprintf("unknown, failed to recover the sender: %v", err)
+	}
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	_, err = client.CallContract(ctx, msg, receipt.BlockNumber)
+	if reason, ok := revertReason(err); ok {
+		return reason
+	}
+	if receipt.GasUsed >= tx.Gas() {
+		return fmt.Sprintf("out of gas, all %d gas was used", tx.Gas())
+	}
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprintf("unknown, the call no longer fails at block %s", receipt.BlockNumber)
+}
+
+// decodeRevert decodes revert data as a standard Error(string) or
+// Panic(uint256), or as a custom error of one of the token variants. Unknown
+// data is returned hex encoded.
+func decodeRevert(data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if len(data) < 4 {
+		return hexutil.Encode(data)
+	}
+
+	for _, variant := range tokenVariants {
+		parsed, err := variant.MetaData.GetAbi()
+		if err != nil {
+			continue
+		}
+		for _, abiErr := range parsed.Errors {
+			if !bytes.Equal(abiErr.ID[:4], data[:4]) {
+				continue
+			}
+			values, err := abiErr.Unpack(data)
+			if err != nil {
+				return abiErr.Name
+			}
+			args := make([]string, 0, len(abiErr.Inputs))
+			for _, value := range values.([]interface{}) {
+				if addr, ok := value.(common.Address); ok {
+					args = append(args, addr.Hex())
+				} else {
+					args = append(args, fmt.Sprint(value))
+				}
+			}
+			return fmt.Sprintf("%s(%s)", abiErr.Name, strings.Join(args, ", "))
+		}
+	}
+	return hexutil.Encode(data)
+}
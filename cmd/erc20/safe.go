@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// safeABI holds the parts of a Safe (v1.3.0 and later) used to propose a
+// transaction.
+const safeABI = `[
+{"inputs":[],"name":"nonce","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[],"name":"getThreshold","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"name":"owner","type":"address"}],"name":"isOwner","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"_nonce","type":"uint256"}],"name":"getTransactionHash","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"}
+]`
+
+// createCallABI is the Safe CreateCall library, which a Safe delegatecalls
+// to create a contract itself.
+const createCallABI = `[{"inputs":[{"name":"value","type":"uint256"},{"name":"deploymentData","type":"bytes"}],"name":"performCreate","outputs":[{"name":"newContract","type":"address"}],"stateMutability":"nonpayable","type":"function"}]`
+
+// defaultCreateCall is the CreateCall library of Safe v1.3.0, deployed at the
+// same address on every chain Safe supports.
+var defaultCreateCall = common.HexToAddress("0x7cbB62EaA69F79e6873cD1ecB2392971036cFAa4")
+
+// Safe transaction operations.
+const (
+	safeCall         = 0
+	safeDelegateCall = 1
+)
+
+// safeProposal is a transaction proposed to the Safe Transaction Service.
+type safeProposal struct {
+	To                      string `json:"to"`
+	Value                   string `json:"value"`
+	Data                    string `json:"data"`
+	Operation               uint8  `json:"operation"`
+	SafeTxGas               string `json:"safeTxGas"`
+	BaseGas                 string `json:"baseGas"`
+	GasPrice                string `json:"gasPrice"`
+	GasToken                string `json:"gasToken"`
+	RefundReceiver          string `json:"refundReceiver"`
+	Nonce                   uint64 `json:"nonce"`
+	ContractTransactionHash string `json:"contractTransactionHash"`
+	Sender                  string `json:"sender"`
+	Signature               string `json:"signature"`
+	Origin                  string `json:"origin"`
+}
+
+// safeDeployment says how a deployment is proposed from a Safe.
+type safeDeployment struct {
+	safe       common.Address
+	service    string         // Safe Transaction Service URL
+	createCall common.Address // CreateCall library for plain deployments
+	nonce      int64          // Safe nonce to propose with, -1 for the current one
+}
+
+// proposeSafeDeployment proposes the planned deployment as a transaction of
+// the Safe, signed by account as the proposer, instead of sending it. A
+// plain deployment delegatecalls the CreateCall library so the Safe creates
+// the token, a -create2 one calls the factory. The Safe's owners then sign
+// it in the Safe app, and it runs once the threshold is reached and someone
+// executes it.
+func proposeSafeDeployment(plan *deployPlan, account *signer, proposal safeDeployment) error {
+	if account.key == nil {
+		return fmt.Errorf("proposing a Safe transaction requires a private key, -ledger and -signer-url are not supported")
+	}
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the Ethereum network: %w", err)
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	if proposal.service == "" {
+		for _, n := range networks {
+			if chainID.Cmp(big.NewInt(n.ChainID)) == 0 {
+				proposal.service = n.SafeService
+			}
+		}
+		if proposal.service == "" {
+			return fmt.Errorf("no known Safe Transaction Service for chain ID %s, set one with -safe-service", chainID)
+		}
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(safeABI))
+	if err != nil {
+		return fmt.Errorf("failed to parse Safe ABI: %w", err)
+	}
+	safe := bind.NewBoundContract(proposal.safe, parsed, client, nil, nil)
+	if err := requireCode(ctx, client, proposal.safe, "Safe"); err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	threshold, err := callSafe[*big.Int](safe, opts, "getThreshold")
+	if err != nil {
+		return fmt.Errorf("%s does not look like a Safe: %w", proposal.safe.Hex(), err)
+	}
+	owner, err := callSafe[bool](safe, opts, "isOwner", account.address)
+	if err != nil {
+		return fmt.Errorf("failed to check the Safe owners: %w", err)
+	}
+	if !owner {
+		slog.Warn("The proposer is not an owner of the Safe, the Transaction Service only accepts the proposal from a delegate", "proposer", account.address.Hex())
+	}
+	nonce := uint64(proposal.nonce)
+	if proposal.nonce < 0 {
+		current, err := callSafe[*big.Int](safe, opts, "nonce")
+		if err != nil {
+			return fmt.Errorf("failed to read the Safe nonce: %w", err)
+		}
+		nonce = current.Uint64()
+	}
+
+	deployData, err := plan.variant.DeployData(plan.ctorArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to encode deployment data: %w", err)
+	}
+	var to, predicted common.Address
+	var data []byte
+	var operation uint8
+	if plan.create2 {
+		if err := requireCode(ctx, client, plan.factory, "CREATE2 factory"); err != nil {
+			return err
+		}
+		to, data, operation = plan.factory, create2Calldata(plan.salt, deployData), safeCall
+		predicted = predictCreate2Address(plan.factory, proposal.safe, plan.salt, deployData)
+	} else {
+		if err := requireCode(ctx, client, proposal.createCall, "CreateCall library"); err != nil {
+			return err
+		}
+		createCall, err := abi.JSON(strings.NewReader(createCallABI))
+		if err != nil {
+			return fmt.Errorf("failed to parse CreateCall ABI: %w", err)
+		}
+		if data, err = createCall.Pack("performCreate", new(big.Int), deployData); err != nil {
+			return fmt.Errorf("failed to encode the CreateCall call: %w", err)
+		}
+		to, operation = proposal.createCall, safeDelegateCall
+		// A contract created by the Safe gets the address of the Safe's
+		// account nonce when the proposal is executed.
+		safeNonce, err := client.NonceAt(ctx, proposal.safe, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get the Safe's account nonce: %w", err)
+		}
+		predicted = crypto.CreateAddress(proposal.safe, safeNonce)
+	}
+
+	safeTxHash, err := callSafe[[32]byte](safe, opts, "getTransactionHash", to, new(big.Int), data, operation,
+		new(big.Int), new(big.Int), new(big.Int), common.Address{}, common.Address{}, new(big.Int).SetUint64(nonce))
+	if err != nil {
+		return fmt.Errorf("failed to compute the Safe transaction hash: %w", err)
+	}
+	signature, err := crypto.Sign(safeTxHash[:], account.key)
+	if err != nil {
+		return fmt.Errorf("failed to sign the Safe transaction: %w", err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "propose deploying "+plan.variant.Name+" from Safe "+proposal.safe.Hex()); err != nil {
+		return err
+	}
+	err = postSafeProposal(ctx, proposal.service, proposal.safe, safeProposal{
+		To:                      to.Hex(),
+		Value:                   "0",
+		Data:                    hexutil.Encode(data),
+		Operation:               operation,
+		SafeTxGas:               "0",
+		BaseGas:                 "0",
+		GasPrice:                "0",
+		GasToken:                common.Address{}.Hex(),
+		RefundReceiver:          common.Address{}.Hex(),
+		Nonce:                   nonce,
+		ContractTransactionHash: hexutil.Encode(safeTxHash[:]),
+		Sender:                  account.address.Hex(),
+		Signature:               hexutil.Encode(signature),
+		Origin:                  "tokken",
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deployment proposed to Safe %s\n", proposal.safe.Hex())
+	fmt.Printf("SafeTxHash: %s\n", hexutil.Encode(safeTxHash[:]))
+	fmt.Printf("Safe nonce: %d\n", nonce)
+	if plan.create2 {
+		fmt.Printf("Token address: %s\n", predicted.Hex())
+	} else {
+		fmt.Printf("Token address: %s, if the Safe creates no other contract before this proposal executes\n", predicted.Hex())
+	}
+	fmt.Printf("Nothing is deployed yet: the token is created once the proposal has the Safe's threshold of %s owner signatures and is executed, e.g. in the Safe app.\n", threshold)
+	return nil
+}
+
+// callSafe calls method of the bound Safe and returns its only result.
+func callSafe[T any](safe *bind.BoundContract, opts *bind.CallOpts, method string, args ...interface{}) (T, error) {
+	var results []interface{}
+	var out T
+	if err := safe.Call(opts, &results, method, args...); err != nil {
+		return out, err
+	}
+	if len(results) != 1 {
+		return out, fmt.Errorf("%s returned %d values", method, len(results))
+	}
+	return *abi.ConvertType(results[0], new(T)).(*T), nil
+}
+
+// requireCode checks that a contract exists at address.
+func requireCode(ctx context.Context, client *ethclient.Client, address common.Address, what string) error {
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to read the code of the %s: %w", what, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no %s at %s on this network", what, address.Hex())
+	}
+	return nil
+}
+
+// postSafeProposal submits proposal to the Safe Transaction Service at
+// service.
+func postSafeProposal(ctx context.Context, service string, safe common.Address, proposal safeProposal) error {
+	body, err := json.Marshal(proposal)
+	if err != nil {
+		return err
+	}
+	endpoint := strings.TrimRight(service, "/") + "/api/v1/safes/" + safe.Hex() + "/multisig-transactions/"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("safe transaction service request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("the Safe Transaction Service refused the proposal (HTTP %d): %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+func runSendEth(args []string) {
+	fs := newFlagSet("send-eth")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	to := fs.String("to", "", "Recipient address")
+	amount := fs.String("amount", "", "Amount of ether to send, e.g. 0.05")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *to == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -to, -amount")
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid recipient address: %v", err)
+	}
+	value, err := deployer.ParseUnits(*amount, 18)
+	if err != nil {
+		fatalf("Invalid amount %q: %v", *amount, err)
+	}
+	recipient := common.HexToAddress(*to)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		fatalf("Failed to get chain ID: %v", err)
+	}
+
+	// Plain accounts take 21000 gas, contracts may need more to receive.
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{From: auth.From, To: &recipient, Value: value})
+	if err != nil {
+		fatal(txError("send ether", err))
+	}
+
+	var txdata types.TxData
+	if auth.GasPrice != nil {
+		txdata = &types.LegacyTx{Nonce: auth.Nonce.Uint64(), GasPrice: auth.GasPrice, Gas: gas, To: &recipient, Value: value}
+	} else {
+		txdata = &types.DynamicFeeTx{ChainID: chainID, Nonce: auth.Nonce.Uint64(), GasTipCap: auth.GasTipCap, GasFeeCap: auth.GasFeeCap, Gas: gas, To: &recipient, Value: value}
+	}
+	tx, err := auth.Signer(auth.From, types.NewTx(txdata))
+	if err != nil {
+		fatalf("Failed to sign transaction: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "send "+*amount+" ETH"); err != nil {
+		fatal(err)
+	}
+
+	receipt, err := broadcastAndWait(ctx, client, tx, "Transfer")
+	if err != nil {
+		fatalf("Transfer failed: %v", err)
+	}
+	fmt.Printf("Block: %s\n", receipt.BlockNumber)
+	if receipt.EffectiveGasPrice != nil {
+		fee := receipt.EffectiveGasPrice.Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed))
+		fmt.Printf("Fee paid: %s ETH\n", formatAmount(fee, 18))
+	}
+
+	balance, err := client.BalanceAt(ctx, recipient, receipt.BlockNumber)
+	if err == nil {
+		fmt.Printf("Recipient balance: %s ETH\n", formatAmount(balance, 18))
+	}
+}
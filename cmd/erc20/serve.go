@@ -0,0 +1,412 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+const (
+	// apiKeyEnv holds the API key of serve when -api-key is not given.
+	apiKeyEnv = "TOKKEN_API_KEY"
+	// apiKeyHeader carries the API key in every deploy request.
+	apiKeyHeader = "X-API-Key"
+	// maxRequestBody bounds the size of a deploy request.
+	maxRequestBody = 1 << 16
+)
+
+// deployRequest is the JSON body of POST /deploy. Unknown fields are
+// rejected, so a client sending a key by mistake gets an error rather than a
+// deployment from the server account.
+type deployRequest struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals *uint  `json:"decimals"` // 18 if omitted
+	Supply   string `json:"supply"`   // in whole units
+	Network  string `json:"network"`  // the -rpc or -network endpoint if omitted
+}
+
+func runServe(args []string) {
+	fs := newFlagSet("serve")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	listen := fs.String("listen", "127.0.0.1:8080", "Address to accept deploy requests on")
+	apiKey := fs.String("api-key", "", "Key clients must send in the "+apiKeyHeader+" header (default: $"+apiKeyEnv+")")
+	networksFlag := fs.String("networks", "", "Comma-separated network presets requests may pick with \"network\", besides the -rpc or -network endpoint")
+	maxRunning := fs.Int("max-concurrent", 8, "Most deployments in progress at once, across all networks")
+	maxQueued := fs.Int("max-queue", 32, "Most requests waiting for a deployment slot, further ones get 429 Too Many Requests")
+	perSecond := fs.Float64("rate", 0, "Most deploy requests accepted per second, further ones get 429 Too Many Requests (default: no limit)")
+	shutdownTimeout := fs.Duration("shutdown-timeout", time.Minute, "How long to let running deployments finish on SIGINT or SIGTERM")
+	keystoresFlag := fs.String("keystores", "", "Comma-separated keystore files sharing -passphrase, whose accounts take turns deploying")
+	accountCount := fs.Int("accounts", 1, "Number of accounts derived from -mnemonic at consecutive -hdpath indexes, taking turns deploying")
+	nonceSync := fs.Duration("nonce-sync", time.Minute, "How often the locally tracked nonces are compared with the node")
+	metricsAddr := fs.String("metrics-addr", "", "Address to serve Prometheus metrics on at /metrics, e.g. :9090 (default: no metrics)")
+	fs.Parse(args)
+
+	names, err := parseNetworkList(*networksFlag)
+	if err != nil {
+		fatal(err)
+	}
+	if rpcURL == "" && networkName == "" && len(names) == 0 {
+		fatal("One of -rpc, -network or -networks is required")
+	}
+	if *apiKey == "" {
+		*apiKey = os.Getenv(apiKeyEnv)
+	}
+	if *apiKey == "" {
+		fatalf("An API key is required: set -api-key or $%s", apiKeyEnv)
+	}
+	if *accountCount < 1 || *nonceSync <= 0 {
+		fatal("The -accounts must be at least 1 and -nonce-sync positive")
+	}
+	if *maxRunning < 1 || *maxQueued < 0 || *perSecond < 0 {
+		fatal("The -max-concurrent must be at least 1, -max-queue and -rate must not be negative")
+	}
+	if nonceOverride >= 0 {
+		fatal("The -nonce flag cannot be used with serve, every deployment takes the next nonce")
+	}
+	// The server runs until interrupted, -timeout bounds each deployment.
+	requestTimeout := timeout
+	timeout = 0
+
+	var keystores []string
+	if *keystoresFlag != "" {
+		keystores = strings.Split(*keystoresFlag, ",")
+	}
+	signers, err := loadSigners(keystores, *accountCount)
+	if err != nil {
+		fatalf("Failed to load signing key: %v", err)
+	}
+	for _, account := range signers {
+		defer account.Close()
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	s := &deployServer{
+		apiKey:   *apiKey,
+		timeout:  requestTimeout,
+		limits:   newAdmission(*maxRunning, *maxQueued, *perSecond),
+		networks: make(map[string]*servedNetwork),
+	}
+	if rpcURL != "" || networkName != "" {
+		client, err := dialClient(ctx)
+		if err != nil {
+			fatalf("Failed to connect to the Ethereum network: %v", err)
+		}
+		defer client.Close()
+		name := ""
+		if preset, err := lookupNetwork(networkName); err == nil {
+			name = preset.Name
+		}
+		s.fallback = &servedNetwork{name: name, client: client, pool: newAccountPool(signers)}
+		s.networks[name] = s.fallback
+	}
+	for _, name := range names {
+		if s.networks[name] != nil {
+			continue
+		}
+		preset, _ := lookupNetwork(name)
+		client, err := dialEndpoint(ctx, preset.RPC, preset)
+		if err != nil {
+			fatalf("Failed to connect to %s: %v", name, err)
+		}
+		defer client.Close()
+		s.networks[name] = &servedNetwork{name: name, client: client, pool: newAccountPool(signers)}
+	}
+	for _, n := range s.served() {
+		if err := confirmBroadcast(ctx, os.Stdout, s.networks[n].client, "serve deployments"); err != nil {
+			fatal(err)
+		}
+	}
+
+	for _, n := range s.networks {
+		go n.pool.reconcile(ctx, n.name, n.client, *nonceSync)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/deploy", s.handleDeploy)
+	servers := []*http.Server{{Addr: *listen, Handler: mux}}
+	if *metricsAddr != "" {
+		s.metrics = newServerMetrics()
+		for _, n := range s.networks {
+			go s.metrics.pollGasPrice(ctx, n.name, n.client)
+		}
+		if *metricsAddr == *listen {
+			mux.Handle("/metrics", s.metrics)
+		} else {
+			metricsMux := http.NewServeMux()
+			metricsMux.Handle("/metrics", s.metrics)
+			servers = append(servers, &http.Server{Addr: *metricsAddr, Handler: metricsMux})
+		}
+	}
+
+	errs := make(chan error, len(servers))
+	for _, srv := range servers {
+		slog.Info("Listening", "addr", srv.Addr)
+		go func() { errs <- srv.ListenAndServe() }()
+	}
+	fmt.Printf("Deploying from %s, send requests to http://%s/deploy\n", addresses(signers), *listen)
+
+	select {
+	case <-ctx.Done():
+	case err := <-errs:
+		fatalf("Server failed: %v", err)
+	}
+
+	// Stop accepting requests and let running deployments finish, so their
+	// results reach the clients.
+	slog.Info("Shutting down, waiting for running deployments", "timeout", *shutdownTimeout)
+	shutdownCtx, stop := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer stop()
+	for _, srv := range servers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("Deployments still running at shutdown, check their transactions", "err", err)
+			srv.Close()
+		}
+	}
+}
+
+// deployServer deploys the standard token for each request, from the
+// accounts of a pool in turn.
+type deployServer struct {
+	apiKey  string
+	timeout time.Duration
+	metrics *serverMetrics // nil without -metrics-addr
+	limits  *admission
+
+	// networks are the chains requests may deploy to, by preset name. The
+	// -rpc endpoint, if any, has no name.
+	networks map[string]*servedNetwork
+	// fallback serves requests without "network", nil when only -networks
+	// is given.
+	fallback *servedNetwork
+}
+
+// servedNetwork is a chain the server deploys to.
+type servedNetwork struct {
+	name   string
+	client *ethclient.Client
+	// pool holds the accounts and their nonces on the chain. Requests for
+	// one account queue for it and are signed and sent one at a time, as
+	// every deployment takes the next nonce. Otherwise concurrent requests
+	// would read the same pending nonce and all but one fail with "nonce
+	// too low" or "already known". Waiting for mining runs concurrently.
+	pool *accountPool
+}
+
+// served returns the names of the networks requests may pick.
+func (s *deployServer) served() []string {
+	names := make([]string, 0, len(s.networks))
+	for name := range s.networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// network returns the network a request asks for.
+func (s *deployServer) network(name string) (*servedNetwork, error) {
+	if name == "" {
+		if s.fallback == nil {
+			return nil, fmt.Errorf("%w: the network is required", deployer.ErrInvalidParams)
+		}
+		return s.fallback, nil
+	}
+	preset, err := lookupNetwork(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", deployer.ErrInvalidParams, err)
+	}
+	n := s.networks[preset.Name]
+	if n == nil {
+		return nil, fmt.Errorf("%w: network %s is not served, expected one of: %s", deployer.ErrInvalidParams, preset.Name, strings.Join(s.served(), ", "))
+	}
+	return n, nil
+}
+
+func (s *deployServer) handleDeploy(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSONError(w, http.StatusMethodNotAllowed, errors.New("use POST"))
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(apiKeyHeader)), []byte(s.apiKey)) != 1 {
+		writeJSONError(w, http.StatusUnauthorized, fmt.Errorf("missing or wrong %s header", apiKeyHeader))
+		return
+	}
+	var req deployRequest
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+	params, err := req.params()
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	n, err := s.network(req.Network)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := r.Context()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	release, err := s.limits.acquire(ctx)
+	if err != nil {
+		if errors.Is(err, errRateLimited) || errors.Is(err, errQueueFull) {
+			w.Header().Set("Retry-After", "1")
+			writeJSONError(w, http.StatusTooManyRequests, err)
+		} else {
+			writeJSONError(w, deployErrorStatus(err), err)
+		}
+		return
+	}
+	defer release()
+	result, err := s.deploy(ctx, n, params)
+	if err != nil {
+		if result == nil {
+			slog.Warn("Deployment failed", "network", n.name, "name", params.Name, "symbol", params.Symbol, "err", err)
+			writeJSONError(w, deployErrorStatus(err), err)
+			return
+		}
+		slog.Warn("Deployment failed", "network", n.name, "name", params.Name, "symbol", params.Symbol, "tx", result.TransactionHash, "err", err)
+		result.Error = err.Error()
+		writeJSON(w, deployErrorStatus(err), result)
+		return
+	}
+	slog.Info("Deployed token", "network", n.name, "name", params.Name, "symbol", params.Symbol, "address", result.ContractAddress, "tx", result.TransactionHash)
+	writeJSON(w, http.StatusOK, result)
+}
+
+// params validates the request and converts it to constructor arguments.
+func (req *deployRequest) params() (deployer.Params, error) {
+	decimals := uint(18)
+	if req.Decimals != nil {
+		decimals = *req.Decimals
+	}
+	if req.Supply == "" {
+		return deployer.Params{}, fmt.Errorf("%w: the total supply is required", deployer.ErrInvalidParams)
+	}
+	if err := deployer.ValidateDecimals(decimals, false); err != nil {
+		return deployer.Params{}, err
+	}
+	supply, err := deployer.ParseSupply(req.Supply, uint8(decimals))
+	if err != nil {
+		return deployer.Params{}, fmt.Errorf("%w: %w", deployer.ErrInvalidParams, err)
+	}
+	if err := deployer.ValidateParams(req.Name, req.Symbol, decimals, supply, false); err != nil {
+		return deployer.Params{}, err
+	}
+	return deployer.Params{Name: req.Name, Symbol: req.Symbol, Decimals: uint8(decimals), Supply: supply}, nil
+}
+
+// deploy sends the deployment on n and waits for it to be mined. Once sent,
+// the result is returned even if the deployment fails, so the caller learns
+// the transaction hash.
+func (s *deployServer) deploy(ctx context.Context, n *servedNetwork, params deployer.Params) (*deployResult, error) {
+	s.metrics.attempt()
+	account := n.pool.pick()
+	d, sent, err := s.send(ctx, n, account, params)
+	if err != nil {
+		s.metrics.fail()
+		return nil, err
+	}
+
+	result := &deployResult{
+		SchemaVersion:   deployResultSchemaVersion,
+		ContractAddress: sent.Address.Hex(),
+		TransactionHash: sent.Transaction.Hash().Hex(),
+		Deployer:        account.signer.address.Hex(),
+		Network:         n.name,
+	}
+	receipt, err := d.Wait(ctx, sent.Transaction)
+	account.mined()
+	if receipt == nil {
+		s.metrics.fail()
+		return result, err
+	}
+	s.metrics.mined(receipt.GasUsed, receipt.Status == 1)
+	if read, readErr := readDeployResult(ctx, n.client, sent.Transaction, receipt, sent.Address, account.signer.address); readErr == nil {
+		result = read
+		result.Network = n.name
+	} else {
+		result.Status, result.GasUsed = receipt.Status, receipt.GasUsed
+	}
+	if err != nil && result.RevertReason != "" {
+		err = fmt.Errorf("%w: %s", err, result.RevertReason)
+	}
+	return result, err
+}
+
+// send signs and sends the deployment from account with its next nonce on
+// n.
+func (s *deployServer) send(ctx context.Context, n *servedNetwork, account *pooledAccount, params deployer.Params) (*deployer.Deployer, *deployer.Result, error) {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+	cfg, err := transactorConfig(ctx, account.signer, n.client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create transactor: %w", err)
+	}
+	nonce, err := account.reserve(ctx, n.client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", deployer.ErrRPC, err)
+	}
+	cfg.Nonce = &nonce
+	auth, err := newTransactor(ctx, n.client, cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create transactor: %w", err)
+	}
+	d := &deployer.Deployer{Client: n.client, Transactor: auth, Params: params}
+	sent, err := d.Deploy(ctx)
+	if err != nil {
+		account.failed()
+		return nil, nil, err
+	}
+	account.sent()
+	return d, sent, nil
+}
+
+// deployErrorStatus maps a failed deployment to an HTTP status.
+func deployErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, deployer.ErrInvalidParams):
+		return http.StatusBadRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, deployer.ErrInsufficientFunds):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, deployer.ErrRPC):
+		return http.StatusBadGateway
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// signer is the account transactions are sent from, backed either by a
+// private key held in memory, by a hardware wallet or by an external signer.
+type signer struct {
+	address common.Address
+	key     *ecdsa.PrivateKey
+
+	wallet   accounts.Wallet
+	account  accounts.Account
+	external bool // wallet is the external signer of -signer-url
+}
+
+// loadSigner connects to the external signer if -signer-url is set, opens
+// the Ledger device if -ledger is set and otherwise loads the private key
+// via loadPrivateKey.
+func loadSigner() (*signer, error) {
+	if signerURL != "" {
+		if privateKey != "" || keystorePath != "" || mnemonic != "" || useLedger {
+			return nil, fmt.Errorf("-signer-url cannot be combined with -key, -keystore, -mnemonic or -ledger")
+		}
+		return openExternalSigner(signerURL, signerAccount)
+	}
+	if signerAccount != "" {
+		return nil, fmt.Errorf("-signer-account requires -signer-url")
+	}
+	if useLedger {
+		if privateKey != "" || keystorePath != "" || mnemonic != "" {
+			return nil, fmt.Errorf("-ledger cannot be combined with -key, -keystore or -mnemonic")
+		}
+		return openLedger(hdPath)
+	}
+
+	key, err := loadPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &signer{address: crypto.PubkeyToAddress(key.PublicKey), key: key}, nil
+}
+
+// transactOpts returns transact options that sign for the account on the
+// given chain.
+func (s *signer) transactOpts(chainID *big.Int) (*bind.TransactOpts, error) {
+	if s.key != nil {
+		return bind.NewKeyedTransactorWithChainID(s.key, chainID)
+	}
+
+	return &bind.TransactOpts{
+		From: s.address,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != s.address {
+				return nil, bind.ErrNotAuthorized
+			}
+			if s.external {
+				slog.Info("Waiting for the external signer to approve the transaction", "nonce", tx.Nonce())
+				signed, err := s.wallet.SignTx(s.account, tx, chainID)
+				if err != nil {
+					return nil, externalSignError(err)
+				}
+				return signed, nil
+			}
+			signed, err := s.wallet.SignTx(s.account, tx, chainID)
+			if err != nil {
+				return nil, ledgerSignError(err)
+			}
+			return signed, nil
+		},
+	}, nil
+}
+
+// Close releases the hardware wallet, if any. An external signer has no
+// connection to release.
+func (s *signer) Close() {
+	if s.wallet != nil && !s.external {
+		s.wallet.Close()
+	}
+}
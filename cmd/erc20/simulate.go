@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+func runSimulateTransfer(args []string) {
+	fs := newFlagSet("simulate-transfer")
+	addRPCFlag(fs)
+	addAccountFlags(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	fromFlag := fs.String("from", "", "Address sending the tokens (defaults to the configured account)")
+	to := fs.String("to", "", "Recipient address")
+	amount := fs.String("amount", "", "Amount of tokens to transfer (in whole units)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *to == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -to, -amount")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if *fromFlag != "" {
+		if _, err := parseAddress(*fromFlag); err != nil {
+			fatalf("Invalid sender address: %v", err)
+		}
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid recipient address: %v", err)
+	}
+
+	var from common.Address
+	if *fromFlag != "" {
+		from = common.HexToAddress(*fromFlag)
+	} else {
+		account, err := loadSigner()
+		if err != nil {
+			fatalf("Failed to load account for -from: %v", err)
+		}
+		from = account.address
+		account.Close()
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	token := common.HexToAddress(*contract)
+	instance, err := NewERC20Token(token, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := instance.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	reason, err := simulateTransfer(ctx, client, token, from, common.HexToAddress(*to), value)
+	if err != nil {
+		fatal(err)
+	}
+	fmt.Printf("From: %s\n", from.Hex())
+	fmt.Printf("To: %s\n", common.HexToAddress(*to).Hex())
+	fmt.Printf("Amount: %s\n", *amount)
+	if reason != "" {
+		fatalfCode(exitReverted, "Transfer would fail: %s", reason)
+	}
+	fmt.Println("Transfer would succeed")
+}
+
+// simulateTransfer calls transfer(to, value) on token from the account from
+// at the latest block, without sending a transaction. It returns why the
+// transfer would fail, decoded from the revert data, or an empty string if it
+// would succeed. The error is only set when the call itself fails.
+func simulateTransfer(ctx context.Context, client *ethclient.Client, token, from, to common.Address, value *big.Int) (string, error) {
+	parsed, err := ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse token ABI: %w", err)
+	}
+	data, err := parsed.Pack("transfer", to, value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transfer: %w", err)
+	}
+
+	var reverted error
+	out, err := withRetry(ctx, "simulate transfer", func() ([]byte, error) {
+		out, err := client.CallContract(ctx, ethereum.CallMsg{From: from, To: &token, Data: data}, nil)
+		if err != nil && isRevert(err) {
+			// A revert is the answer, not a failure to retry.
+			reverted = err
+			return nil, nil
+		}
+		return out, err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to simulate transfer: %w", err)
+	}
+	if reverted != nil {
+		if reason, ok := revertReason(reverted); ok {
+			return reason, nil
+		}
+		return reverted.Error(), nil
+	}
+	// Tokens that don't revert signal a failed transfer by returning false.
+	if len(out) > 0 {
+		results, err := parsed.Unpack("transfer", out)
+		if err == nil && len(results) == 1 {
+			if ok, isBool := results[0].(bool); isBool && !ok {
+				return "transfer returned false", nil
+			}
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// snapshotCheckpointBlocks is how many blocks are replayed between two
+// writes of the -checkpoint file.
+const snapshotCheckpointBlocks = 100000
+
+// snapshotCheckpoint is the progress of a snapshot, saved so that an
+// interrupted run over a long history resumes where it stopped.
+type snapshotCheckpoint struct {
+	Contract  string            `json:"contract"`
+	FromBlock uint64            `json:"fromBlock"`
+	Block     uint64            `json:"block"`
+	NextBlock uint64            `json:"nextBlock"`
+	Balances  map[string]string `json:"balances"` // raw balances by address
+}
+
+func runSnapshot(args []string) {
+	fs := newFlagSet("snapshot")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	block := fs.Int64("block", -1, "Block to take the balances at")
+	fromBlock := fs.Uint64("from-block", 0, "First block to replay transfers from, e.g. the deployment block, which saves requests on long chains")
+	chunk := fs.Uint64("chunk", 5000, "Blocks per eth_getLogs request, halved automatically when the provider returns too many results")
+	out := fs.String("out", "", "CSV file to write the address,balance rows to, balances in whole units as airdrop reads them")
+	checkpointPath := fs.String("checkpoint", "", "File the progress is saved to every 100000 blocks and resumed from if it exists (default: -out with .checkpoint.json appended)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *block < 0 || *out == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -block, -out")
+	}
+	token, err := parseAddress(*contract)
+	if err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if *chunk == 0 {
+		fatal("The -chunk must be at least 1 block")
+	}
+	end := uint64(*block)
+	if end < *fromBlock {
+		fatalf("The -block %d is before the -from-block %d", end, *fromBlock)
+	}
+	if *checkpointPath == "" {
+		*checkpointPath = *out + ".checkpoint.json"
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(token, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := instance.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	latest, err := withRetry(ctx, "get block number", func() (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+	if err != nil {
+		fatalf("Failed to get the latest block: %v", err)
+	}
+	if end > latest {
+		fatalf("The -block %d is beyond the latest block %d", end, latest)
+	}
+
+	checkpoint, err := loadSnapshotCheckpoint(*checkpointPath, token, *fromBlock, end)
+	if err != nil {
+		fatal(err)
+	}
+	balances := make(map[common.Address]*big.Int, len(checkpoint.Balances))
+	for address, balance := range checkpoint.Balances {
+		balances[common.HexToAddress(address)], _ = new(big.Int).SetString(balance, 10)
+	}
+	if checkpoint.NextBlock > *fromBlock {
+		fmt.Printf("Resuming from block %d with %d holders, as saved in %s\n", checkpoint.NextBlock, len(balances), *checkpointPath)
+	}
+
+	for start := checkpoint.NextBlock; start <= end; {
+		stop := min(start+snapshotCheckpointBlocks-1, end)
+		err := forEachTransfer(ctx, instance, start, stop, *chunk, func(event *ERC20TokenTransfer) error {
+			// Mints come from and burns go to the zero address, which holds
+			// nothing.
+			if event.From != (common.Address{}) {
+				balances[event.From] = new(big.Int).Sub(balanceOf(balances, event.From), event.Value)
+			}
+			if event.To != (common.Address{}) {
+				balances[event.To] = new(big.Int).Add(balanceOf(balances, event.To), event.Value)
+			}
+			return nil
+		})
+		if err != nil {
+			fatalf("Failed to replay transfers: %v", err)
+		}
+		start = stop + 1
+		if start <= end {
+			if err := saveSnapshotCheckpoint(*checkpointPath, checkpoint, balances, start); err != nil {
+				fatal(err)
+			}
+			slog.Info("Replayed transfers", "upTo", stop, "of", end, "holders", len(balances))
+		}
+	}
+
+	type holder struct {
+		address common.Address
+		balance *big.Int
+	}
+	var holders []holder
+	total := new(big.Int)
+	for address, balance := range balances {
+		if balance.Sign() < 0 {
+			slog.Warn("Replayed balance is negative, the token's Transfer events don't account for every balance change", "address", address.Hex(), "balance", balance)
+		}
+		if balance.Sign() != 0 {
+			holders = append(holders, holder{address, balance})
+			total.Add(total, balance)
+		}
+	}
+	// Largest holders first, ties broken by address for a stable file.
+	sort.Slice(holders, func(i, j int) bool {
+		if c := holders[i].balance.Cmp(holders[j].balance); c != 0 {
+			return c > 0
+		}
+		return holders[i].address.Cmp(holders[j].address) < 0
+	})
+
+	file, err := os.Create(*out)
+	if err != nil {
+		fatalf("Failed to create %s: %v", *out, err)
+	}
+	defer file.Close()
+	w := csv.NewWriter(file)
+	w.Write([]string{"address", "balance"})
+	for _, h := range holders {
+		w.Write([]string{h.address.Hex(), formatAmount(h.balance, decimals)})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fatalf("Failed to write %s: %v", *out, err)
+	}
+	if err := file.Close(); err != nil {
+		fatalf("Failed to write %s: %v", *out, err)
+	}
+	if err := os.Remove(*checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Warn("Failed to remove the checkpoint file", "path", *checkpointPath, "err", err)
+	}
+	fmt.Printf("Wrote %d holders at block %d to %s\n", len(holders), end, *out)
+
+	if err := checkSnapshotSupply(ctx, instance, end, total, decimals); err != nil {
+		fatal(err)
+	}
+}
+
+// balanceOf returns the balance of address in balances, zero if it has none.
+func balanceOf(balances map[common.Address]*big.Int, address common.Address) *big.Int {
+	if balance, ok := balances[address]; ok {
+		return balance
+	}
+	return new(big.Int)
+}
+
+// checkSnapshotSupply compares the sum of the replayed balances with the
+// token's total supply at block, which needs a node keeping historical
+// state.
+func checkSnapshotSupply(ctx context.Context, instance *ERC20Token, block uint64, total *big.Int, decimals uint8) error {
+	supply, err := instance.TotalSupply(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)})
+	if err != nil {
+		slog.Warn("Could not read the total supply at the block to check the snapshot, the node may not keep historical state", "block", block, "err", err)
+		return nil
+	}
+	if supply.Cmp(total) != 0 {
+		return fmt.Errorf("the snapshot balances add up to %s but the total supply at block %d is %s; the token changes balances without Transfer events, e.g. by rebasing, and cannot be snapshotted this way",
+			formatAmount(total, decimals), block, formatAmount(supply, decimals))
+	}
+	fmt.Printf("Balances add up to the total supply at block %d: %s\n", block, formatAmount(supply, decimals))
+	return nil
+}
+
+// loadSnapshotCheckpoint returns the progress saved at path for the same
+// token and block range, or a fresh one starting at from if there is none.
+func loadSnapshotCheckpoint(path string, token common.Address, from, block uint64) (*snapshotCheckpoint, error) {
+	fresh := &snapshotCheckpoint{Contract: token.Hex(), FromBlock: from, Block: block, NextBlock: from, Balances: map[string]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fresh, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	var saved snapshotCheckpoint
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint %s: %w", path, err)
+	}
+	if saved.Contract != fresh.Contract || saved.FromBlock != from || saved.Block != block {
+		return nil, fmt.Errorf("the checkpoint %s is for %s in blocks %d-%d, remove it to start over", path, saved.Contract, saved.FromBlock, saved.Block)
+	}
+	for address, balance := range saved.Balances {
+		if _, ok := new(big.Int).SetString(balance, 10); !ok || !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("the checkpoint %s is corrupt, remove it to start over", path)
+		}
+	}
+	if saved.Balances == nil {
+		saved.Balances = map[string]string{}
+	}
+	return &saved, nil
+}
+
+// saveSnapshotCheckpoint writes balances and the next block to replay to
+// path. The file is replaced in one step so that an interruption while
+// writing leaves the previous checkpoint intact.
+func saveSnapshotCheckpoint(path string, checkpoint *snapshotCheckpoint, balances map[common.Address]*big.Int, next uint64) error {
+	checkpoint.NextBlock = next
+	checkpoint.Balances = make(map[string]string, len(balances))
+	for address, balance := range balances {
+		checkpoint.Balances[address.Hex()] = balance.String()
+	}
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path+".tmp", data, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var sourcifyURL string
+
+// sourcifyResponse is the reply of the Sourcify /verify endpoint.
+type sourcifyResponse struct {
+	Result []struct {
+		Address string `json:"address"`
+		ChainID string `json:"chainId"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	} `json:"result"`
+	Error string `json:"error"`
+}
+
+// verifyOnSourcify uploads the metadata and sources of the contract at
+// address, or its standard JSON input, to the Sourcify server and reports
+// whether it achieved a full or partial match.
+func verifyOnSourcify(ctx context.Context, w io.Writer, client *ethclient.Client, variant tokenVariant, address common.Address) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+
+	bundle, err := loadSourceBundle(artifactsDir, variant)
+	if err != nil {
+		return err
+	}
+	// Without the metadata from a build-info, Sourcify compiles the standard
+	// JSON input itself.
+	endpoint := "/verify"
+	var request map[string]interface{}
+	if bundle.Metadata == "" {
+		endpoint = "/verify/solc-json"
+		request = map[string]interface{}{
+			"address":         address.Hex(),
+			"chain":           chainID.String(),
+			"files":           map[string]string{"solc-input.json": string(bundle.Input)},
+			"compilerVersion": bundle.CompilerVersion,
+			"contractName":    bundle.ContractName,
+		}
+	} else {
+		files, err := bundle.Sources()
+		if err != nil {
+			return err
+		}
+		files["metadata.json"] = bundle.Metadata
+		request = map[string]interface{}{
+			"address": address.Hex(),
+			"chain":   chainID.String(),
+			"files":   files,
+		}
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Submitting %s for verification on Sourcify...\n", bundle.FullyQualifiedName())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(sourcifyURL, "/")+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sourcify request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result sourcifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("invalid sourcify response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if result.Error != "" {
+		return fmt.Errorf("%s", result.Error)
+	}
+	if len(result.Result) == 0 {
+		return fmt.Errorf("sourcify returned no result (HTTP %d)", resp.StatusCode)
+	}
+
+	switch match := result.Result[0]; match.Status {
+	case "perfect":
+		fmt.Fprintf(w, "Verification successful: full match\n")
+	case "partial":
+		fmt.Fprintf(w, "Verification successful: partial match (metadata differs)\n")
+	default:
+		return fmt.Errorf("unexpected status %q: %s", match.Status, match.Message)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// DeployTaxERC20Token deploys a new TaxERC20Token contract, sending taxBps
+// basis points of every transfer to treasury, and binds an instance of it.
+func DeployTaxERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int, taxBps uint16, treasury common.Address) (common.Address, *types.Transaction, *TaxERC20Token, error) {
+	address, tx, err := taxToken.Deploy(auth, backend, name, symbol, decimals, supply, taxBps, treasury)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewTaxERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+// maxTaxBps is the highest -tax-bps, 10%, matching MAX_TAX_BPS of the
+// TaxERC20Token contract.
+const maxTaxBps = 1000
+
+// taxTestAmount is the amount, in base units, of the test transfer of
+// -verify-effects. The tax on it is exactly the tax in basis points.
+var taxTestAmount = big.NewInt(10000)
+
+// verifyTransferTax checks the fee-on-transfer token just deployed at token
+// by sending taxTestAmount from the deployer to itself: its balance has to
+// drop by the tax, which the treasury has to receive. auth.Nonce is still
+// the one of the deployment and is advanced for the transfer.
+func verifyTransferTax(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, plan *deployPlan, token common.Address, progress io.Writer) error {
+	if plan.treasury == auth.From {
+		slog.Warn("The deployer is the treasury, whose transfers are not taxed, skipping the test transfer")
+		return nil
+	}
+	instance, err := NewTaxERC20Token(token, client)
+	if err != nil {
+		return fmt.Errorf("failed to bind token contract: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	senderBefore, err := instance.BalanceOf(opts, auth.From)
+	if err != nil {
+		return fmt.Errorf("failed to query balance: %w", err)
+	}
+	if senderBefore.Cmp(taxTestAmount) < 0 {
+		slog.Warn("The deployer holds too few tokens for the test transfer, skipping it", "needed", taxTestAmount)
+		return nil
+	}
+	treasuryBefore, err := instance.BalanceOf(opts, plan.treasury)
+	if err != nil {
+		return fmt.Errorf("failed to query treasury balance: %w", err)
+	}
+
+	auth.Nonce.Add(auth.Nonce, common.Big1)
+	// The gas limit was estimated for the deployment, let the binding
+	// estimate the transfer instead.
+	auth.GasLimit = 0
+	tx, err := instance.Transfer(auth, auth.From, taxTestAmount)
+	if err != nil {
+		return txError("send the test transfer", err)
+	}
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return fmt.Errorf("failed to send the test transfer: %w", err)
+	}
+	fmt.Fprintf(progress, "Sending a test transfer of %s base units to check the tax, transaction hash: %s\n", taxTestAmount, tx.Hash().Hex())
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for the test transfer: %w", err)
+	}
+	if receipt.Status != 1 {
+		return withExitCode(exitReverted, fmt.Errorf("the test transfer failed: %s", receiptRevertReason(ctx, client, tx, receipt)))
+	}
+
+	// Read the balances at the transfer's block so later transfers don't skew them.
+	opts.BlockNumber = receipt.BlockNumber
+	senderAfter, err := instance.BalanceOf(opts, auth.From)
+	if err != nil {
+		return fmt.Errorf("failed to query balance: %w", err)
+	}
+	treasuryAfter, err := instance.BalanceOf(opts, plan.treasury)
+	if err != nil {
+		return fmt.Errorf("failed to query treasury balance: %w", err)
+	}
+	expected := new(big.Int).Div(new(big.Int).Mul(taxTestAmount, big.NewInt(int64(plan.taxBps))), big.NewInt(10000))
+	paid := new(big.Int).Sub(senderBefore, senderAfter)
+	received := new(big.Int).Sub(treasuryAfter, treasuryBefore)
+	if paid.Cmp(expected) != 0 || received.Cmp(expected) != 0 {
+		return fmt.Errorf("the test transfer of %s base units cost the sender %s and paid the treasury %s, expected a tax of %s", taxTestAmount, paid, received, expected)
+	}
+	fmt.Fprintf(progress, "Transfer tax verified: %s of %s base units went to the treasury %s\n", received, taxTestAmount, plan.treasury.Hex())
+	return nil
+}
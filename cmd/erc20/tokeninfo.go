@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// unavailable is printed for token fields whose getter is missing or returns
+// something that doesn't decode.
+const unavailable = "unavailable"
+
+// legacyTokenABI declares name() and symbol() returning bytes32, as some
+// early tokens such as MKR do instead of string.
+const legacyTokenABI = `[{"inputs":[],"name":"name","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"}]`
+
+// readTokenString calls the name or symbol getter of a token. When the result
+// doesn't decode as a string it is read again as bytes32, with the trailing
+// zero bytes trimmed.
+func readTokenString(opts *bind.CallOpts, client bind.ContractCaller, address common.Address, method string) (string, error) {
+	parsed, err := ERC20TokenMetaData.GetAbi()
+	if err != nil {
+		return "", err
+	}
+	var out []interface{}
+	callErr := bind.NewBoundContract(address, *parsed, client, nil, nil).Call(opts, &out, method)
+	if callErr == nil {
+		return *abi.ConvertType(out[0], new(string)).(*string), nil
+	}
+
+	legacy, err := abi.JSON(strings.NewReader(legacyTokenABI))
+	if err != nil {
+		return "", err
+	}
+	out = nil
+	if err := bind.NewBoundContract(address, legacy, client, nil, nil).Call(opts, &out, method); err != nil {
+		return "", callErr
+	}
+	raw := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	value := bytes.TrimRight(raw[:], "\x00")
+	if !utf8.Valid(value) {
+		return "", fmt.Errorf("%s() returned bytes32 %x that is not text", method, raw)
+	}
+	return string(value), nil
+}
+
+func runTokenInfo(args []string) {
+	fs := newFlagSet("token-info")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of any ERC20 token contract")
+	block := addBlockFlag(fs)
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	address := common.HexToAddress(*contract)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	opts, err := block.callOpts(ctx, client)
+	if err != nil {
+		fatal(err)
+	}
+	var code []byte
+	if opts.Pending {
+		code, err = client.PendingCodeAt(ctx, address)
+	} else {
+		code, err = client.CodeAt(ctx, address, opts.BlockNumber)
+	}
+	if err != nil {
+		fatalf("Failed to read contract code: %v", err)
+	}
+	if len(code) == 0 {
+		fatalf("No contract at %s on this network at block %s", address.Hex(), describeBlock(opts))
+	}
+
+	instance, err := NewERC20Token(address, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	// name, symbol and decimals are optional in ERC20, so every field is
+	// read on its own and reported as unavailable when the call fails.
+	name, symbol, decimals, supply := unavailable, unavailable, unavailable, unavailable
+	if v, err := readTokenString(opts, client, address, "name"); err == nil {
+		name = v
+	}
+	if v, err := readTokenString(opts, client, address, "symbol"); err == nil {
+		symbol = v
+	}
+	d, decimalsErr := instance.Decimals(opts)
+	if decimalsErr == nil {
+		decimals = fmt.Sprint(d)
+	}
+	if v, err := instance.TotalSupply(opts); err == nil {
+		if decimalsErr == nil {
+			supply = formatAmount(v, d)
+		} else {
+			supply = v.String() + " (base units)"
+		}
+	}
+
+	fmt.Printf("Contract: %s\n", address.Hex())
+	fmt.Printf("Block: %s\n", describeBlock(opts))
+	fmt.Printf("Token name: %s\n", name)
+	fmt.Printf("Token symbol: %s\n", symbol)
+	fmt.Printf("Token decimals: %s\n", decimals)
+	fmt.Printf("Total supply: %s\n", supply)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// returningCaller is a bind.ContractCaller whose contract returns result to
+// every call.
+type returningCaller struct {
+	result []byte
+}
+
+func (c returningCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (c returningCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return c.result, nil
+}
+
+func bytes32Result(s string) []byte {
+	var word [32]byte
+	copy(word[:], s)
+	return word[:]
+}
+
+func stringResult(t *testing.T, s string) []byte {
+	t.Helper()
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := abi.Arguments{{Type: stringType}}.Pack(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestReadTokenString(t *testing.T) {
+	tests := []struct {
+		name   string
+		result []byte
+		want   string
+	}{
+		{name: "string", result: stringResult(t, "Maker"), want: "Maker"},
+		{name: "empty string", result: stringResult(t, ""), want: ""},
+		{name: "null-padded bytes32", result: bytes32Result("MKR"), want: "MKR"},
+		{name: "full bytes32", result: bytes32Result("ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"), want: "ABCDEFGHIJKLMNOPQRSTUVWXYZ012345"},
+		{name: "empty bytes32", result: bytes32Result(""), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readTokenString(&bind.CallOpts{}, returningCaller{tt.result}, common.Address{}, "symbol")
+			if err != nil {
+				t.Fatalf("readTokenString() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("readTokenString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadTokenStringInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		result []byte
+	}{
+		{name: "bytes32 not text", result: bytes32Result("\xff\xfe")},
+		{name: "too short", result: []byte{0x4d, 0x4b, 0x52}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := readTokenString(&bind.CallOpts{}, returningCaller{tt.result}, common.Address{}, "name"); err == nil {
+				t.Errorf("readTokenString() = %q, want an error", got)
+			}
+		})
+	}
+}
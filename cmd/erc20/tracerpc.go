@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// traceRPC logs every JSON-RPC request and its response, set with -trace-rpc.
+var traceRPC bool
+
+// traceValueLimit caps how much of a params or result value is logged, so
+// bytecode and logs don't flood the terminal.
+const traceValueLimit = 512
+
+// dialRPC connects to url, tracing the requests with -trace-rpc. Only HTTP
+// endpoints can be traced; websocket and IPC ones are used as they are.
+func dialRPC(ctx context.Context, url string) (*ethclient.Client, error) {
+	if !traceRPC {
+		return ethclient.DialContext(ctx, url)
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		slog.Warn("Only HTTP endpoints can be traced, -trace-rpc has no effect on this one")
+		return ethclient.DialContext(ctx, url)
+	}
+	client, err := rpc.DialOptions(ctx, url, rpc.WithHTTPClient(&http.Client{
+		Transport: tracingTransport{base: http.DefaultTransport},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return ethclient.NewClient(client), nil
+}
+
+// tracingTransport logs the JSON-RPC messages going through it.
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+// rpcMessage is a JSON-RPC request or response.
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+func (t tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	requests := decodeRPCMessages(body)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Microsecond)
+	if err != nil {
+		for _, r := range requests {
+			slog.Info("RPC", "method", r.Method, "params", traceParams(r), "duration", elapsed, "err", err)
+		}
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	if err != nil {
+		return nil, err
+	}
+	responses := make(map[string]rpcMessage)
+	for _, r := range decodeRPCMessages(respBody) {
+		responses[string(r.ID)] = r
+	}
+
+	for _, r := range requests {
+		attrs := []interface{}{"method", r.Method, "params", traceParams(r), "duration", elapsed}
+		if resp.StatusCode != http.StatusOK {
+			attrs = append(attrs, "status", resp.Status)
+			if after := resp.Header.Get("Retry-After"); after != "" {
+				attrs = append(attrs, "retryAfter", after)
+			}
+		}
+		response, ok := responses[string(r.ID)]
+		switch {
+		case !ok:
+			attrs = append(attrs, "body", truncateTrace(string(respBody)))
+		case response.Error != nil:
+			attrs = append(attrs, "error", fmt.Sprintf("%d: %s", response.Error.Code, response.Error.Message))
+		default:
+			attrs = append(attrs, "result", truncateTrace(string(response.Result)))
+		}
+		slog.Info("RPC", attrs...)
+	}
+	return resp, nil
+}
+
+// decodeRPCMessages decodes a single JSON-RPC message or a batch, returning
+// nil for anything else.
+func decodeRPCMessages(data []byte) []rpcMessage {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var batch []rpcMessage
+		if json.Unmarshal(data, &batch) != nil {
+			return nil
+		}
+		return batch
+	}
+	var msg rpcMessage
+	if json.Unmarshal(data, &msg) != nil {
+		return nil
+	}
+	return []rpcMessage{msg}
+}
+
+// traceParams returns the params of r for logging. A signed transaction is
+// replaced by its hash and size, and the params of signing methods, which a
+// node holding keys could be sent, are left out.
+func traceParams(r rpcMessage) string {
+	switch {
+	case r.Method == "eth_sendRawTransaction":
+		var params []hexutil.Bytes
+		var tx types.Transaction
+		if json.Unmarshal(r.Params, &params) == nil && len(params) == 1 && tx.UnmarshalBinary(params[0]) == nil {
+			return fmt.Sprintf("[signed transaction %s, %d bytes]", tx.Hash().Hex(), len(params[0]))
+		}
+		return "[signed transaction]"
+	case strings.HasPrefix(r.Method, "personal_") || strings.HasPrefix(r.Method, "eth_sign"):
+		return "[redacted]"
+	}
+	return truncateTrace(string(r.Params))
+}
+
+// truncateTrace shortens s to traceValueLimit bytes.
+func truncateTrace(s string) string {
+	if len(s) <= traceValueLimit {
+		return s
+	}
+	return fmt.Sprintf("%s... (%d bytes)", s[:traceValueLimit], len(s))
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func runTrack(args []string) {
+	fs := newFlagSet("track")
+	addRPCFlag(fs)
+	txFlag := fs.String("tx", "", "Hash of an already broadcast transaction, e.g. an interrupted deployment")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *txFlag == "" {
+		fatal("All flags are required: -rpc (or -network), -tx")
+	}
+	if b, err := hexutil.Decode(*txFlag); err != nil || len(b) != common.HashLength {
+		fatalf("Invalid transaction hash: %s", *txFlag)
+	}
+	hash := common.HexToHash(*txFlag)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	tx, pending, err := client.TransactionByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		fatalf("Transaction %s not found: this node has never seen it or it was dropped, check that -rpc points to the network it was sent to", hash.Hex())
+	}
+	if err != nil {
+		fatalf("Failed to get transaction: %v", err)
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		fatalf("Failed to recover the sender: %v", err)
+	}
+
+	fmt.Printf("Transaction hash: %s\n", hash.Hex())
+	fmt.Printf("From: %s\n", from.Hex())
+	fmt.Printf("Nonce: %d\n", tx.Nonce())
+
+	// Deployments are recognised as contract creations or as calls of the
+	// default CREATE2 factory, whose call data is the salt and init code.
+	var address common.Address
+	deployment := true
+	switch {
+	case tx.To() == nil:
+		address = crypto.CreateAddress(from, tx.Nonce())
+	case *tx.To() == defaultCreate2Factory() && len(tx.Data()) > 32:
+		var salt [32]byte
+		copy(salt[:], tx.Data()[:32])
+		address = predictCreate2Address(*tx.To(), from, salt, tx.Data()[32:])
+	default:
+		deployment = false
+		fmt.Printf("To: %s\n", tx.To().Hex())
+	}
+	if deployment {
+		fmt.Printf("Contract address: %s\n", address.Hex())
+	}
+
+	if pending {
+		fmt.Printf("Status: pending\n")
+		fmt.Printf("Waiting for transaction to be mined...\n")
+	}
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		fatalf("Failed to wait for mining: %v", err)
+	}
+	fmt.Printf("Block: %s\n", receipt.BlockNumber)
+
+	if !deployment {
+		if receipt.Status == 1 {
+			fmt.Printf("\nTransaction successful!\n")
+		} else {
+			fmt.Printf("\nTransaction failed: %s\n", receiptRevertReason(ctx, client, tx, receipt))
+			exitStatus = exitReverted
+		}
+		fmt.Printf("Gas used: %d\n", receipt.GasUsed)
+		return
+	}
+
+	result, err := readDeployResult(ctx, client, tx, receipt, address, from)
+	if err != nil {
+		fatal(err)
+	}
+	if result.Status != 1 {
+		fatalfCode(exitReverted, "Deployment failed: %s", result.RevertReason)
+	}
+	printDeploySummary(result)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func runTransfer(args []string) {
+	fs := newFlagSet("transfer")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	to := fs.String("to", "", "Recipient address")
+	amount := fs.String("amount", "", "Amount of tokens to transfer (in whole units)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *to == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -to, -amount")
+	}
+
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid recipient address: %v", err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	decimals, err := tokenDecimals(ctx, client, common.HexToAddress(*contract))
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	value, err := parseAmount(*amount, decimals)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "transfer "+*amount+" tokens"); err != nil {
+		fatal(err)
+	}
+
+	tx, err := sendNext(ctx, client, auth, newNonceManager(client, auth), "transfer", func() (*types.Transaction, error) {
+		return instance.Transfer(auth, common.HexToAddress(*to), value)
+	})
+	if err != nil {
+		fatal(err)
+	}
+	if _, err := awaitTransaction(ctx, client, tx, "Transfer"); err != nil {
+		fatalf("Transfer failed: %v", err)
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// sendTransaction broadcasts a signed transaction, to every endpoint when
+// -rpcs lists several.
+func sendTransaction(ctx context.Context, client *ethclient.Client, tx *types.Transaction) error {
+	var err error
+	if len(broadcastClients) > 0 {
+		err = broadcastTransaction(ctx, client, tx)
+	} else {
+		err = sendTransactionTo(ctx, client, tx)
+	}
+	// A send cut short by an interrupt may still have reached the node.
+	if err == nil || ctx.Err() != nil {
+		recordBroadcast(tx.Hash())
+	}
+	return err
+}
+
+// sendTransactionTo sends a signed transaction to one endpoint, retrying
+// transient failures. Because a retried send carries the same signed
+// transaction, an "already known" reply means an earlier attempt reached the
+// node.
+func sendTransactionTo(ctx context.Context, client *ethclient.Client, tx *types.Transaction) error {
+	_, err := withRetry(ctx, "send transaction", func() (struct{}, error) {
+		err := client.SendTransaction(ctx, tx)
+		if err != nil && strings.Contains(err.Error(), "already known") {
+			return struct{}{}, nil
+		}
+		return struct{}{}, err
+	})
+	return err
+}
+
+// confirmations is the number of blocks, counting the one including the
+// transaction, that waitMined waits for.
+var confirmations uint64
+
+// waitMined waits for tx to be mined and buried under -confirmations blocks.
+// waitReceipt keeps going through transient receipt lookup errors, so only
+// the context ends the wait early, in which case the error names the
+// transaction so it can be tracked.
+func waitMined(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := waitReceipt(ctx, client, tx)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, withExitCode(exitTimeout, fmt.Errorf("transaction %s not mined within timeout, follow it with 'erc20 track -tx %[1]s'", tx.Hash().Hex()))
+	case errors.Is(err, context.Canceled):
+		return nil, fmt.Errorf("interrupted before transaction %s was mined, follow it with 'erc20 track -tx %[1]s'", tx.Hash().Hex())
+	case err != nil:
+		return nil, err
+	}
+
+	receipt, err = waitConfirmations(ctx, client, tx, receipt)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, withExitCode(exitTimeout, fmt.Errorf("transaction %s mined but not confirmed %d times within timeout", tx.Hash().Hex(), confirmations))
+	case errors.Is(err, context.Canceled):
+		return nil, fmt.Errorf("interrupted before transaction %s was confirmed %d times", tx.Hash().Hex(), confirmations)
+	}
+	return receipt, err
+}
+
+// waitReceipt returns the receipt of tx once it is mined. Over WebSocket and
+// IPC connections the receipt is looked up on each new head announced by a
+// subscription instead of being polled for, saving requests on rate-limited
+// providers. If the subscription cannot be set up or breaks, the wait falls
+// back to polling with bind.WaitMined.
+func waitReceipt(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+	if !client.Client().SupportsSubscriptions() {
+		return bind.WaitMined(ctx, client, tx)
+	}
+	heads := make(chan *types.Header, 16)
+	sub, err := client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		slog.Debug("Failed to subscribe to new heads, polling instead", "err", err)
+		return bind.WaitMined(ctx, client, tx)
+	}
+	defer sub.Unsubscribe()
+
+	// The transaction may have been mined before the subscription started.
+	for {
+		receipt, err := client.TransactionReceipt(ctx, tx.Hash())
+		if err == nil {
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			slog.Debug("Failed to get receipt", "tx", tx.Hash().Hex(), "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case err := <-sub.Err():
+			slog.Debug("New head subscription failed, polling instead", "err", err)
+			return bind.WaitMined(ctx, client, tx)
+		case <-heads:
+		}
+	}
+}
+
+// waitConfirmations polls the chain head until the block holding receipt has
+// -confirmations blocks on top of it, counting itself. The receipt is looked
+// up again at the end, and if a reorg moved the transaction the wait starts
+// over from its new block.
+func waitConfirmations(ctx context.Context, client *ethclient.Client, tx *types.Transaction, receipt *types.Receipt) (*types.Receipt, error) {
+	if confirmations <= 1 {
+		return receipt, nil
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var reported uint64 = 1
+	slog.Info("Waiting for confirmations", "tx", tx.Hash().Hex(), "block", receipt.BlockNumber, "want", confirmations)
+	for {
+		head, err := client.BlockNumber(ctx)
+		if err != nil {
+			slog.Debug("Failed to read block number", "err", err)
+		} else if mined := receipt.BlockNumber.Uint64(); head >= mined {
+			depth := head - mined + 1
+			if depth > reported {
+				reported = min(depth, confirmations)
+				slog.Info("Confirmation", "tx", tx.Hash().Hex(), "count", reported, "of", confirmations)
+			}
+			if depth >= confirmations {
+				current, err := client.TransactionReceipt(ctx, tx.Hash())
+				if err == nil && current.BlockHash == receipt.BlockHash {
+					return receipt, nil
+				}
+				slog.Warn("Transaction moved by a reorg, waiting again", "tx", tx.Hash().Hex(), "err", err)
+				if receipt, err = waitReceipt(ctx, client, tx); err != nil {
+					return nil, err
+				}
+				reported = 1
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkGasFunds makes sure the sender in auth holds enough ether for the
+// worst case of the transaction about to be sent: its value plus the gas
+// limit at the gas price, or at the fee cap for dynamic-fee transactions.
+func checkGasFunds(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts) error {
+	price := auth.GasPrice
+	if auth.GasFeeCap != nil {
+		price = auth.GasFeeCap
+	}
+	if price == nil {
+		return nil
+	}
+	need := new(big.Int).Mul(price, new(big.Int).SetUint64(auth.GasLimit))
+	if auth.Value != nil {
+		need.Add(need, auth.Value)
+	}
+
+	have, err := withRetry(ctx, "get balance", func() (*big.Int, error) {
+		return client.BalanceAt(ctx, auth.From, nil)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get balance: %w", err)
+	}
+	if have.Cmp(need) < 0 {
+		return fmt.Errorf("%w: need %s ETH, have %s ETH, short by %s ETH", deployer.ErrInsufficientFunds,
+			formatAmount(need, 18), formatAmount(have, 18), formatAmount(new(big.Int).Sub(need, have), 18))
+	}
+	return nil
+}
+
+// txError describes a failure to build a transaction, surfacing the revert
+// reason when the node reports one during gas estimation.
+func txError(action string, err error) error {
+	if reason, ok := revertReason(err); ok {
+		return withExitCode(exitReverted, fmt.Errorf("%s would revert: %s", action, reason))
+	}
+	return fmt.Errorf("failed to %s: %w", action, err)
+}
+
+// newNonceManager returns a nonce manager for the account of auth that
+// continues from the nonce of auth.
+func newNonceManager(client *ethclient.Client, auth *bind.TransactOpts) *deployer.NonceManager {
+	nonces := deployer.NewNonceManager(client, auth.From)
+	nonces.Set(auth.Nonce.Uint64())
+	return nonces
+}
+
+// sendNext sets auth to the next nonce of nonces, signs a transaction with
+// build and sends it. When the node rejects it as "nonce too low", e.g.
+// because the account was used elsewhere meanwhile, it is signed again at the
+// pending nonce and sent once more. Failures of build are described by
+// txError with action.
+func sendNext(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, nonces *deployer.NonceManager, action string, build func() (*types.Transaction, error)) (*types.Transaction, error) {
+	var tx *types.Transaction
+	err := nonces.Send(ctx, func(nonce uint64) error {
+		auth.Nonce = new(big.Int).SetUint64(nonce)
+		var err error
+		if tx, err = build(); err != nil {
+			return txError(action, err)
+		}
+		return sendTransaction(ctx, client, tx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// broadcastAndWait sends tx, reports its hash and waits for it to be mined,
+// printing the outcome under the given label, e.g. "Transfer".
+func broadcastAndWait(ctx context.Context, client *ethclient.Client, tx *types.Transaction, label string) (*types.Receipt, error) {
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return nil, err
+	}
+	return awaitTransaction(ctx, client, tx, label)
+}
+
+// awaitTransaction reports the hash of the sent tx and waits for it to be
+// mined, printing the outcome under label.
+func awaitTransaction(ctx context.Context, client *ethclient.Client, tx *types.Transaction, label string) (*types.Receipt, error) {
+	fmt.Printf("%s submitted!\n", label)
+	fmt.Printf("Transaction hash: %s\n", tx.Hash().Hex())
+	fmt.Printf("Waiting for transaction to be mined...\n")
+
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == 1 {
+		fmt.Printf("\n%s successful!\n", label)
+	} else {
+		fmt.Printf("\n%s failed: %s\n", label, receiptRevertReason(ctx, client, tx, receipt))
+		exitStatus = exitReverted
+	}
+	fmt.Printf("Gas used: %d\n", receipt.GasUsed)
+	return receipt, nil
+}
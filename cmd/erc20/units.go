@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+func runConvert(args []string) {
+	fs := newFlagSet("convert")
+	amount := fs.String("amount", "", "Amount to convert, in whole units with -to-base or in base units with -to-human")
+	decimals := fs.Uint("decimals", 18, "Number of decimals of the token, e.g. 6 for USDC")
+	toBase := fs.Bool("to-base", false, "Convert whole units to base units (the default)")
+	toHuman := fs.Bool("to-human", false, "Convert base units to whole units")
+	fs.Parse(args)
+
+	if *amount == "" {
+		fatal("The -amount flag is required")
+	}
+	if *decimals > 255 {
+		fatal("The -decimals must be at most 255")
+	}
+	if *toBase && *toHuman {
+		fatal("Only one of -to-base and -to-human can be given")
+	}
+
+	if *toHuman {
+		if strings.Contains(*amount, ".") {
+			fatalf("Invalid base unit amount: %s: base units are whole numbers", *amount)
+		}
+		value, err := deployer.ParseUnits(*amount, 0)
+		if err != nil {
+			fatalf("Invalid base unit amount: %s: %v", *amount, err)
+		}
+		fmt.Println(formatAmount(value, uint8(*decimals)))
+		return
+	}
+	value, err := parseAmount(*amount, uint8(*decimals))
+	if err != nil {
+		fatal(err)
+	}
+	fmt.Println(value)
+}
+
+// parseAmount converts a token amount in whole units to base units, like
+// deployer.ParseSupply does for supplies.
+func parseAmount(amount string, decimals uint8) (*big.Int, error) {
+	value, err := deployer.ParseUnits(amount, int(decimals))
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %s: %w", amount, err)
+	}
+	return value, nil
+}
+
+// formatAmount renders a base-unit amount as a decimal string in whole token
+// units, trimming trailing fractional zeros.
+func formatAmount(amount *big.Int, decimals uint8) string {
+	multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(amount), multiplier, new(big.Int))
+
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	if frac.Sign() == 0 {
+		return sign + whole.String()
+	}
+	fracStr := fmt.Sprintf("%0*s", int(decimals), frac.String())
+	return sign + whole.String() + "." + strings.TrimRight(fracStr, "0")
+}
+
+// decimalsCaches holds the decimals read by this process, one cache per
+// client since the same address may be another token on another network.
+var (
+	decimalsMu     sync.Mutex
+	decimalsCaches = map[*ethclient.Client]*deployer.DecimalsCache{}
+)
+
+// tokenDecimals returns the decimals of token on the network of client,
+// asking the node only the first time.
+func tokenDecimals(ctx context.Context, client *ethclient.Client, token common.Address) (uint8, error) {
+	decimalsMu.Lock()
+	cache, ok := decimalsCaches[client]
+	if !ok {
+		cache = deployer.NewDecimalsCache(client)
+		decimalsCaches[client] = cache
+	}
+	decimalsMu.Unlock()
+	return cache.Decimals(ctx, token)
+}
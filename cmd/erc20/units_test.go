@@ -0,0 +1,60 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAmountRoundTrip(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals uint8
+		base     string
+	}{
+		{amount: "1", decimals: 6, base: "1000000"},
+		{amount: "0.000001", decimals: 6, base: "1"},
+		{amount: "1234.5", decimals: 6, base: "1234500000"},
+		{amount: "0", decimals: 6, base: "0"},
+		{amount: "1", decimals: 18, base: "1000000000000000000"},
+		{amount: "0.000000000000000001", decimals: 18, base: "1"},
+		{amount: "0.25", decimals: 18, base: "250000000000000000"},
+		{amount: "1000000000.123456789012345678", decimals: 18, base: "1000000000123456789012345678"},
+	}
+	for _, tt := range tests {
+		value, err := parseAmount(tt.amount, tt.decimals)
+		if err != nil {
+			t.Errorf("parseAmount(%q, %d) failed: %v", tt.amount, tt.decimals, err)
+			continue
+		}
+		if value.String() != tt.base {
+			t.Errorf("parseAmount(%q, %d) = %s, want %s", tt.amount, tt.decimals, value, tt.base)
+		}
+		if got := formatAmount(value, tt.decimals); got != tt.amount {
+			t.Errorf("formatAmount(%s, %d) = %q, want %q", value, tt.decimals, got, tt.amount)
+		}
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		base     int64
+		decimals uint8
+		want     string
+	}{
+		{base: 1500000, decimals: 6, want: "1.5"},
+		{base: 10, decimals: 6, want: "0.00001"},
+		{base: -2500000, decimals: 6, want: "-2.5"},
+		{base: 42, decimals: 0, want: "42"},
+	}
+	for _, tt := range tests {
+		if got := formatAmount(big.NewInt(tt.base), tt.decimals); got != tt.want {
+			t.Errorf("formatAmount(%d, %d) = %q, want %q", tt.base, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestParseAmountTooPrecise(t *testing.T) {
+	if value, err := parseAmount("0.0000001", 6); err == nil {
+		t.Errorf("parseAmount(\"0.0000001\", 6) = %s, want an error", value)
+	}
+}
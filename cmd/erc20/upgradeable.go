@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	transparentProxy = tokenVariant{"TransparentUpgradeableProxy", TransparentUpgradeableProxyMetaData}
+	erc1967Proxy     = tokenVariant{"ERC1967Proxy", ERC1967ProxyMetaData}
+)
+
+// erc1967AdminSlot is the ERC-1967 storage slot holding the admin of a
+// proxy, for transparent proxies the ProxyAdmin contract they created.
+var erc1967AdminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+
+// proxyDeployment returns the proxy contract of an -upgradeable deployment
+// and its constructor arguments. The arguments include the initialize call
+// of the implementation, which the proxy constructor makes, so the token is
+// never left uninitialized where someone else could initialize it first.
+func proxyDeployment(plan *deployPlan, implementation, admin common.Address) (tokenVariant, []interface{}, error) {
+	parsed, err := plan.variant.MetaData.GetAbi()
+	if err != nil {
+		return tokenVariant{}, nil, err
+	}
+	if plan.proxyType == "uups" {
+		initData, err := parsed.Pack("initialize", plan.name, plan.symbol, plan.decimals, plan.supply, admin)
+		if err != nil {
+			return tokenVariant{}, nil, fmt.Errorf("failed to encode initialize call: %w", err)
+		}
+		return erc1967Proxy, []interface{}{implementation, initData}, nil
+	}
+	initData, err := parsed.Pack("initialize", plan.name, plan.symbol, plan.decimals, plan.supply)
+	if err != nil {
+		return tokenVariant{}, nil, fmt.Errorf("failed to encode initialize call: %w", err)
+	}
+	return transparentProxy, []interface{}{implementation, admin, initData}, nil
+}
+
+// deployImplementation deploys the implementation of an -upgradeable
+// deployment, which must end up at expected, and advances the nonce in auth
+// for the proxy that follows. It returns the receipt of the implementation.
+func deployImplementation(ctx context.Context, client *ethclient.Client, auth *bind.TransactOpts, plan *deployPlan, expected common.Address, progress io.Writer) (*types.Receipt, error) {
+	data, err := plan.variant.DeployData()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode implementation deployment: %w", err)
+	}
+	gas := auth.GasLimit
+	auth.GasLimit = estimateDeployGas(ctx, client, ethereum.CallMsg{From: auth.From, Data: data})
+	address, tx, err := plan.variant.Deploy(auth, client)
+	auth.GasLimit = gas
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy implementation: %w", err)
+	}
+	if err := sendTransaction(ctx, client, tx); err != nil {
+		return nil, fmt.Errorf("failed to deploy implementation: %w", err)
+	}
+	fmt.Fprintf(progress, "Deploying implementation %s at %s, transaction hash: %s\n", plan.variant.Name, address.Hex(), tx.Hash().Hex())
+
+	receipt, err := waitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for the implementation: %w", err)
+	}
+	if receipt.Status != 1 {
+		return nil, withExitCode(exitReverted, fmt.Errorf("implementation deployment failed: %s", receiptRevertReason(ctx, client, tx, receipt)))
+	}
+	if address != expected {
+		return nil, fmt.Errorf("implementation was deployed at %s instead of %s, another transaction used the nonce", address.Hex(), expected.Hex())
+	}
+	auth.Nonce.Add(auth.Nonce, common.Big1)
+	return receipt, nil
+}
+
+// readProxyAdmin returns the admin recorded in the ERC-1967 admin slot of a
+// proxy.
+func readProxyAdmin(ctx context.Context, client *ethclient.Client, proxy common.Address) (common.Address, error) {
+	value, err := client.StorageAt(ctx, proxy, erc1967AdminSlot, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(value), nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/devlongs/erc20-cli/contracts"
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// The standard token binding lives in the deployer package, which deploys it
+// for library users.
+type (
+	ERC20Token         = deployer.ERC20Token
+	ERC20TokenTransfer = deployer.ERC20TokenTransfer
+)
+
+var (
+	NewERC20Token      = deployer.NewERC20Token
+	ERC20TokenMetaData = deployer.ERC20TokenMetaData
+)
+
+// tokenVariant is a deployable token contract. The standard token has its
+// bytecode compiled into the binding; the other variants are bound from their
+// ABI only and load their bytecode from the Hardhat artifact in -artifacts.
+type tokenVariant struct {
+	Name     string // contract, artifact and source file name
+	MetaData *bind.MetaData
+}
+
+var (
+	standardToken   = tokenVariant{"ERC20Token", ERC20TokenMetaData}
+	mintableToken   = tokenVariant{"MintableERC20Token", MintableERC20TokenMetaData}
+	burnableToken   = tokenVariant{"BurnableERC20Token", BurnableERC20TokenMetaData}
+	pausableToken   = tokenVariant{"PausableERC20Token", PausableERC20TokenMetaData}
+	cappedToken     = tokenVariant{"CappedERC20Token", CappedERC20TokenMetaData}
+	permitToken     = tokenVariant{"PermitERC20Token", PermitERC20TokenMetaData}
+	votesToken      = tokenVariant{"VotesERC20Token", VotesERC20TokenMetaData}
+	erc777Token     = tokenVariant{"ERC777Token", ERC777TokenMetaData}
+	erc1363Token    = tokenVariant{"ERC1363Token", ERC1363TokenMetaData}
+	taxToken        = tokenVariant{"TaxERC20Token", TaxERC20TokenMetaData}
+	restrictedToken = tokenVariant{"RestrictedERC20Token", RestrictedERC20TokenMetaData}
+	wrappedToken    = tokenVariant{"WrappedNativeToken", WrappedNativeTokenMetaData}
+	// initializableToken is the implementation behind -clone-of clones and
+	// transparent -upgradeable proxies.
+	initializableToken = tokenVariant{"InitializableERC20Token", InitializableERC20TokenMetaData}
+	// uupsToken is only deployed behind the proxy of -upgradeable.
+	uupsToken = tokenVariant{"UUPSERC20Token", UUPSERC20TokenMetaData}
+)
+
+// tokenVariants lists every known variant, e.g. for decoding custom errors.
+var tokenVariants = []tokenVariant{standardToken, mintableToken, burnableToken, pausableToken, cappedToken, permitToken, votesToken, erc777Token, erc1363Token, taxToken, restrictedToken, wrappedToken, initializableToken, uupsToken}
+
+// variantNames maps the short names accepted by commands that take a
+// -variant flag to the variants.
+var variantNames = map[string]tokenVariant{
+	"standard":   standardToken,
+	"mintable":   mintableToken,
+	"burnable":   burnableToken,
+	"pausable":   pausableToken,
+	"capped":     cappedToken,
+	"permit":     permitToken,
+	"votes":      votesToken,
+	"erc777":     erc777Token,
+	"erc1363":    erc1363Token,
+	"tax":        taxToken,
+	"restricted": restrictedToken,
+	"wrapped":    wrappedToken,
+}
+
+// lookupVariant returns the variant with the given short name.
+func lookupVariant(name string) (tokenVariant, error) {
+	if v, ok := variantNames[name]; ok {
+		return v, nil
+	}
+	return tokenVariant{}, fmt.Errorf("unknown variant %q, expected standard, mintable, burnable, pausable, capped, permit, votes, erc777, erc1363, tax, restricted or wrapped", name)
+}
+
+// Bytecode returns the contract creation bytecode of the variant.
+func (v tokenVariant) Bytecode() ([]byte, error) {
+	if v.MetaData.Bin != "" {
+		return common.FromHex(v.MetaData.Bin), nil
+	}
+
+	var artifact struct {
+		Bytecode string `json:"bytecode"`
+	}
+	path, err := v.readArtifact(&artifact)
+	if err != nil {
+		return nil, err
+	}
+	bytecode := common.FromHex(artifact.Bytecode)
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("artifact %s contains no bytecode", path)
+	}
+	return bytecode, nil
+}
+
+// RuntimeBytecode returns the deployed bytecode of the variant from the
+// deployedBytecode of its Hardhat artifact.
+func (v tokenVariant) RuntimeBytecode() ([]byte, error) {
+	var artifact struct {
+		DeployedBytecode string `json:"deployedBytecode"`
+	}
+	path, err := v.readArtifact(&artifact)
+	if err != nil {
+		return nil, err
+	}
+	bytecode := common.FromHex(artifact.DeployedBytecode)
+	if len(bytecode) == 0 {
+		return nil, fmt.Errorf("artifact %s contains no deployed bytecode", path)
+	}
+	return bytecode, nil
+}
+
+// readArtifact parses the Hardhat artifact of the variant into artifact and
+// returns where it was read from: <Name>.json in -artifacts if there is one,
+// so freshly compiled contracts are picked up, or else the artifact built
+// into the command.
+func (v tokenVariant) readArtifact(artifact interface{}) (string, error) {
+	path := filepath.Join(artifactsDir, v.Name+".json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		path = "contracts/artifacts/" + v.Name + ".json"
+		if data, err = contracts.Artifacts.ReadFile("artifacts/" + v.Name + ".json"); err != nil {
+			return "", fmt.Errorf("%s has no compiled bytecode: build the contracts with npm install && npm run build, then rebuild the command", v.Name)
+		}
+	}
+	if err := json.Unmarshal(data, artifact); err != nil {
+		return "", fmt.Errorf("failed to parse artifact %s: %w", path, err)
+	}
+	return path, nil
+}
+
+// PackConstructor ABI-encodes the constructor arguments of the variant.
+func (v tokenVariant) PackConstructor(args ...interface{}) ([]byte, error) {
+	parsed, err := v.MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	packed, err := parsed.Pack("", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack constructor arguments: %w", err)
+	}
+	return packed, nil
+}
+
+// DeployData returns the creation bytecode followed by the ABI-encoded
+// constructor arguments, exactly as Deploy sends it.
+func (v tokenVariant) DeployData(args ...interface{}) ([]byte, error) {
+	bytecode, err := v.Bytecode()
+	if err != nil {
+		return nil, err
+	}
+	packed, err := v.PackConstructor(args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytecode, packed...), nil
+}
+
+// Deploy creates a contract deployment transaction for the variant.
+func (v tokenVariant) Deploy(auth *bind.TransactOpts, backend bind.ContractBackend, args ...interface{}) (common.Address, *types.Transaction, error) {
+	parsed, err := v.MetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	bytecode, err := v.Bytecode()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	address, tx, _, err := bind.DeployContract(auth, *parsed, bytecode, backend, args...)
+	return address, tx, err
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func runVerifyBytecode(args []string) {
+	fs := newFlagSet("verify-bytecode")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the deployed token")
+	variantName := fs.String("variant", "standard", "Variant the token was deployed as: standard, mintable, burnable, pausable, capped, permit, votes, erc777, erc1363, tax, restricted or wrapped")
+	fs.StringVar(&artifactsDir, "artifacts", "contracts/artifacts", "Directory holding the compiled artifacts")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	variant, err := lookupVariant(*variantName)
+	if err != nil {
+		fatal(err)
+	}
+	expected, err := variant.RuntimeBytecode()
+	if err != nil {
+		fatal(err)
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	address := common.HexToAddress(*contract)
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		fatalf("Failed to read contract code: %v", err)
+	}
+	if len(code) == 0 {
+		fatalf("No contract at %s on this network", address.Hex())
+	}
+
+	onchain, onchainMeta := splitMetadata(code)
+	want, wantMeta := splitMetadata(expected)
+	fmt.Printf("Contract: %s\n", address.Hex())
+	fmt.Printf("Variant: %s\n", variant.Name)
+	fmt.Printf("On-chain code: %d bytes, %d without metadata\n", len(code), len(onchain))
+	fmt.Printf("Expected code: %d bytes, %d without metadata\n", len(expected), len(want))
+
+	if offset := firstDifference(onchain, want); offset >= 0 {
+		fmt.Printf("Result: MISMATCH, first difference at byte offset %d (0x%x)\n", offset, offset)
+		exit(1)
+	}
+	fmt.Printf("Result: match\n")
+	if !bytes.Equal(onchainMeta, wantMeta) {
+		fmt.Printf("Note: the metadata differs, the source was compiled with other settings or comments\n")
+	}
+}
+
+// splitMetadata splits the CBOR metadata solc appends to runtime code off
+// the code. The last two bytes hold the length of the CBOR data before them.
+// Code without a plausible metadata suffix is returned whole.
+func splitMetadata(code []byte) (body, metadata []byte) {
+	if len(code) < 2 {
+		return code, nil
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	// CBOR maps start with 0xa0-0xbf, solc always emits one.
+	if n == 0 || n+2 > len(code) || code[len(code)-2-n]&0xe0 != 0xa0 {
+		return code, nil
+	}
+	return code[:len(code)-2-n], code[len(code)-2-n:]
+}
+
+// firstDifference returns the offset of the first byte where a and b differ,
+// counting a length difference, or -1 when they are equal.
+func firstDifference(a, b []byte) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	if len(a) != len(b) {
+		return min(len(a), len(b))
+	}
+	return -1
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployVotesERC20Token deploys a new VotesERC20Token contract, supporting
+// ERC-5805 vote delegation and checkpoints, and binds an instance of it.
+func DeployVotesERC20Token(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string, decimals uint8, supply *big.Int) (common.Address, *types.Transaction, *VotesERC20Token, error) {
+	address, tx, err := votesToken.Deploy(auth, backend, name, symbol, decimals, supply)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewVotesERC20Token(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runDelegate(args []string) {
+	fs := newFlagSet("delegate")
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -votes")
+	to := fs.String("to", "", "Address to delegate voting power to, your own address to vote yourself")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *to == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -to")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*to); err != nil {
+		fatalf("Invalid delegatee address: %v", err)
+	}
+	delegatee := common.HexToAddress(*to)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewVotesERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	if _, err := instance.Delegates(&bind.CallOpts{Context: ctx}, account.address); err != nil {
+		fatalf("Failed to query delegate, is the token deployed with -votes? %v", err)
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	if err := confirmBroadcast(ctx, os.Stdout, client, "delegate votes to "+delegatee.Hex()); err != nil {
+		fatal(err)
+	}
+
+	tx, err := instance.Delegate(auth, delegatee)
+	if err != nil {
+		fatal(txError("delegate", err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, "Delegation")
+	if err != nil {
+		fatalf("Delegation failed: %v", err)
+	}
+
+	if receipt.Status == 1 {
+		opts := &bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}
+		if votes, err := instance.GetVotes(opts, delegatee); err == nil {
+			if decimals, err := instance.Decimals(opts); err == nil {
+				fmt.Printf("Votes of %s: %s\n", delegatee.Hex(), formatAmount(votes, decimals))
+			}
+		}
+	}
+}
+
+func runVotes(args []string) {
+	fs := newFlagSet("votes")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -votes")
+	address := fs.String("address", "", "Account to show the voting power of")
+	block := fs.String("block", "", "Past block number to read the voting power at with getPastVotes (optional)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *address == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -address")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	if _, err := parseAddress(*address); err != nil {
+		fatalf("Invalid address: %v", err)
+	}
+	var timepoint *big.Int
+	if *block != "" {
+		n, err := strconv.ParseUint(*block, 10, 64)
+		if err != nil {
+			fatalf("Invalid -block: %s", *block)
+		}
+		timepoint = new(big.Int).SetUint64(n)
+	}
+	account := common.HexToAddress(*address)
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	instance, err := NewVotesERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	decimals, err := instance.Decimals(opts)
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+	delegate, err := instance.Delegates(opts, account)
+	if err != nil {
+		fatalf("Failed to query delegate, is the token deployed with -votes? %v", err)
+	}
+
+	fmt.Printf("Account: %s\n", account.Hex())
+	if delegate == (common.Address{}) {
+		fmt.Printf("Delegate: none, the account's tokens don't count as votes until it delegates\n")
+	} else {
+		fmt.Printf("Delegate: %s\n", delegate.Hex())
+	}
+
+	if timepoint == nil {
+		votes, err := instance.GetVotes(opts, account)
+		if err != nil {
+			fatal(txError("get votes", err))
+		}
+		fmt.Printf("Votes: %s\n", formatAmount(votes, decimals))
+		return
+	}
+	// getPastVotes only accepts blocks before the current one.
+	votes, err := instance.GetPastVotes(opts, account, timepoint)
+	if err != nil {
+		fatal(txError("get past votes", err))
+	}
+	fmt.Printf("Votes at block %s: %s\n", timepoint, formatAmount(votes, decimals))
+}
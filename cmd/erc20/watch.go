@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// backfillRange is the number of blocks first tried per eth_getLogs request
+// when catching up on past transfers.
+const backfillRange = 5000
+
+// resubscribeDelay is the pause before subscribing again after a failure.
+const resubscribeDelay = 2 * time.Second
+
+// logPosition is the position of a log in the chain, used to print each
+// transfer once when a backfill and the subscription overlap.
+type logPosition struct {
+	block uint64
+	index uint
+}
+
+// reached reports whether log is at or after p.
+func (p logPosition) reached(log types.Log) bool {
+	return log.BlockNumber > p.block || (log.BlockNumber == p.block && log.Index >= p.index)
+}
+
+func runWatchTransfers(args []string) {
+	fs := newFlagSet("watch-transfers")
+	addRPCFlag(fs)
+	contract := fs.String("contract", "", "Address of the token contract")
+	fromBlock := fs.Int64("from-block", -1, "Print the transfers since this block before watching for new ones (default: only new transfers)")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" {
+		fatal("All flags are required: -rpc (or -network), -contract")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	// Watching runs until interrupted unless -timeout is given.
+	explicit := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+	if !explicit["timeout"] {
+		timeout = 0
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+	if !client.Client().SupportsSubscriptions() {
+		fatal("watch-transfers needs a ws:// or IPC endpoint that supports subscriptions")
+	}
+
+	instance, err := NewERC20Token(common.HexToAddress(*contract), client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+	decimals, err := instance.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		fatalf("Failed to query decimals: %v", err)
+	}
+
+	w := &transferWatcher{client: client, instance: instance, decimals: decimals}
+	if *fromBlock >= 0 {
+		w.next = logPosition{block: uint64(*fromBlock)}
+	} else {
+		head, err := withRetry(ctx, "get block number", func() (uint64, error) {
+			return client.BlockNumber(ctx)
+		})
+		if err != nil {
+			fatalf("Failed to get the latest block: %v", err)
+		}
+		w.next = logPosition{block: head + 1}
+	}
+	fmt.Printf("BLOCK\tFROM\tTO\tVALUE\tTRANSACTION\n")
+	if err := w.run(ctx); err != nil && ctx.Err() == nil {
+		fatal(err)
+	}
+}
+
+// transferWatcher prints the Transfer events of a token as they arrive.
+type transferWatcher struct {
+	client   *ethclient.Client
+	instance *ERC20Token
+	decimals uint8
+	// next is the position of the first transfer not printed yet.
+	next logPosition
+}
+
+// run subscribes to the transfers and prints them until ctx ends. Each time
+// the subscription is set up, the transfers since the last one printed are
+// fetched with eth_getLogs first, so none are lost when it drops.
+func (w *transferWatcher) run(ctx context.Context) error {
+	for {
+		sink := make(chan *ERC20TokenTransfer, 64)
+		sub, err := w.instance.WatchTransfer(&bind.WatchOpts{Context: ctx}, sink, nil, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			slog.Warn("Failed to subscribe to transfers, retrying", "err", err)
+			if err := sleepContext(ctx, resubscribeDelay); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := w.catchUp(ctx); err != nil {
+			sub.Unsubscribe()
+			return err
+		}
+
+		dropped := false
+		for !dropped {
+			select {
+			case <-ctx.Done():
+				sub.Unsubscribe()
+				return ctx.Err()
+			case err := <-sub.Err():
+				slog.Warn("Transfer subscription dropped, resubscribing", "err", err)
+				dropped = true
+			case event := <-sink:
+				w.print(event)
+			}
+		}
+		sub.Unsubscribe()
+	}
+}
+
+// catchUp prints the transfers from the next position up to the current
+// head.
+func (w *transferWatcher) catchUp(ctx context.Context) error {
+	head, err := withRetry(ctx, "get block number", func() (uint64, error) {
+		return w.client.BlockNumber(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	if w.next.block > head {
+		return nil
+	}
+	return forEachTransfer(ctx, w.instance, w.next.block, head, backfillRange, func(event *ERC20TokenTransfer) error {
+		w.print(event)
+		return nil
+	})
+}
+
+// print prints a transfer unless it was printed already. Transfers undone by
+// a reorg are printed again, marked as removed.
+func (w *transferWatcher) print(event *ERC20TokenTransfer) {
+	if event.Raw.Removed {
+		fmt.Printf("%d\t%s\t%s\t-%s\t%s (removed by reorg)\n", event.Raw.BlockNumber, event.From.Hex(), event.To.Hex(),
+			formatAmount(event.Value, w.decimals), event.Raw.TxHash.Hex())
+		return
+	}
+	if !w.next.reached(event.Raw) {
+		return
+	}
+	fmt.Printf("%d\t%s\t%s\t%s\t%s\n", event.Raw.BlockNumber, event.From.Hex(), event.To.Hex(),
+		formatAmount(event.Value, w.decimals), event.Raw.TxHash.Hex())
+	w.next = logPosition{event.Raw.BlockNumber, event.Raw.Index + 1}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+)
+
+func runWhoami(args []string) {
+	fs := newFlagSet("whoami")
+	addRPCFlag(fs)
+	addAccountFlags(fs)
+	fs.Parse(args)
+
+	if rpcURL == "" && networkName == "" {
+		fatal("One of -rpc or -network is required")
+	}
+
+	// The source is described before loadSigner, which may fill -key from
+	// the environment or a prompt.
+	source := accountSource()
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	address := account.address
+	account.Close()
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	chainID, err := withRetry(ctx, "get chain ID", func() (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+	if err != nil {
+		fatalf("Failed to get chain ID: %v", err)
+	}
+	balance, err := withRetry(ctx, "get balance", func() (*big.Int, error) {
+		return client.BalanceAt(ctx, address, nil)
+	})
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	nonce, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+		return client.NonceAt(ctx, address, nil)
+	})
+	if err != nil {
+		fatalf("Failed to get nonce: %v", err)
+	}
+	pending, err := withRetry(ctx, "get nonce", func() (uint64, error) {
+		return client.PendingNonceAt(ctx, address)
+	})
+	if err != nil {
+		fatalf("Failed to get nonce: %v", err)
+	}
+
+	fmt.Printf("Address: %s\n", address.Hex())
+	fmt.Printf("Source: %s\n", source)
+	fmt.Printf("Network: %s (chain ID %s)\n", chainName(chainID), chainID)
+	fmt.Printf("Balance: %s %s\n", formatAmount(balance, 18), networkCurrency(chainName(chainID)))
+	if pending != nonce {
+		fmt.Printf("Nonce: %d (%d pending transactions, next nonce %d)\n", nonce, pending-nonce, pending)
+	} else {
+		fmt.Printf("Nonce: %d\n", nonce)
+	}
+}
+
+// accountSource describes where loadSigner takes the account from, in the
+// same order of precedence.
+func accountSource() string {
+	switch {
+	case signerURL != "":
+		return "external signer at " + signerURL
+	case useLedger:
+		return "Ledger at " + hdPath
+	case privateKey != "":
+		return "-key"
+	case keystorePath != "":
+		return "keystore " + keystorePath
+	case mnemonic != "":
+		return "-mnemonic at " + hdPath
+	case os.Getenv(privateKeyEnv) != "":
+		return "$" + privateKeyEnv
+	case os.Getenv(mnemonicEnv) != "":
+		return "$" + mnemonicEnv + " at " + hdPath
+	}
+	return "prompted private key"
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// runWizard guides a first-time user through a deployment with prompts
+// instead of flags. It runs when the CLI is started without arguments on a
+// terminal, and ends by handing the answers to runDeploy.
+func runWizard() {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Fprintf(os.Stderr, "Deploy a new ERC20 token. Press Ctrl+C at any time to quit.\n")
+	fmt.Fprintf(os.Stderr, "Run 'erc20 -h' to see the flags for non-interactive use.\n\n")
+
+	endpoint := ask(reader, "Network ("+strings.Join(networkNames(), ", ")+") or RPC URL", "", func(v string) error {
+		if _, err := lookupNetwork(v); err == nil {
+			return nil
+		}
+		u, err := url.Parse(v)
+		if err != nil || u.Host == "" {
+			return fmt.Errorf("not a known network or a valid URL")
+		}
+		switch u.Scheme {
+		case "http", "https", "ws", "wss":
+			return nil
+		}
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	})
+	endpointFlag := "-rpc"
+	if _, err := lookupNetwork(endpoint); err == nil {
+		endpointFlag = "-network"
+		networkName = endpoint
+	} else {
+		rpcURL = endpoint
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	client.Close()
+	cancel()
+	if err != nil {
+		fatalf("Failed to get chain ID: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Connected to %s (chain ID %s).\n", chainName(chainID), chainID)
+	if !askYesNo(reader, "Is this the network you want to deploy to?") {
+		fmt.Fprintf(os.Stderr, "Cancelled, nothing was broadcast.\n")
+		return
+	}
+
+	name := ask(reader, "Token name", "", deployer.ValidateName)
+	symbol := ask(reader, "Token symbol", "", deployer.ValidateSymbol)
+	decimals := ask(reader, "Decimals", "18", func(v string) error {
+		d, err := strconv.ParseUint(v, 10, 8)
+		if err != nil {
+			return fmt.Errorf("decimals must be a whole number")
+		}
+		return deployer.ValidateDecimals(uint(d), false)
+	})
+	supply := ask(reader, "Total supply (in whole units)", "", func(v string) error {
+		d, _ := strconv.ParseUint(decimals, 10, 8)
+		value, err := deployer.ParseSupply(v, uint8(d))
+		if err != nil {
+			return err
+		}
+		if value.Sign() <= 0 {
+			return fmt.Errorf("the total supply must be greater than zero")
+		}
+		return nil
+	})
+
+	fmt.Fprintf(os.Stderr, "\nReview your token:\n")
+	fmt.Fprintf(os.Stderr, "  Network:  %s (chain ID %s)\n", chainName(chainID), chainID)
+	fmt.Fprintf(os.Stderr, "  Name:     %s\n", name)
+	fmt.Fprintf(os.Stderr, "  Symbol:   %s\n", symbol)
+	fmt.Fprintf(os.Stderr, "  Decimals: %s\n", decimals)
+	fmt.Fprintf(os.Stderr, "  Supply:   %s\n\n", supply)
+	if !askYesNo(reader, "Deploy this token?") {
+		fmt.Fprintf(os.Stderr, "Cancelled, nothing was broadcast.\n")
+		return
+	}
+
+	// The network and token were confirmed above, so skip the second prompt.
+	runDeploy([]string{
+		endpointFlag, endpoint,
+		"-name", name,
+		"-symbol", symbol,
+		"-decimals", decimals,
+		"-supply", supply,
+		"-yes",
+	})
+}
+
+// ask prompts until the answer passes validate. An empty answer selects def
+// when one is given.
+func ask(reader *bufio.Reader, label, def string, validate func(string) error) string {
+	for {
+		if def != "" {
+			fmt.Fprintf(os.Stderr, "%s [%s]: ", label, def)
+		} else {
+			fmt.Fprintf(os.Stderr, "%s: ", label)
+		}
+		answer, err := reader.ReadString('\n')
+		if err != nil && answer == "" {
+			fatalf("Failed to read answer: %v", err)
+		}
+		answer = strings.TrimSpace(answer)
+		if answer == "" {
+			answer = def
+		}
+		if err := validate(answer); err != nil {
+			fmt.Fprintf(os.Stderr, "  %v, please try again.\n", err)
+			continue
+		}
+		return answer
+	}
+}
+
+// askYesNo asks a yes/no question that defaults to no.
+func askYesNo(reader *bufio.Reader, question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+	answer, err := reader.ReadString('\n')
+	if err != nil && answer == "" {
+		fatalf("Failed to read answer: %v", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
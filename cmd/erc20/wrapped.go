@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DeployWrappedNativeToken deploys a new WrappedNativeToken contract and binds
+// an instance of it.
+func DeployWrappedNativeToken(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string) (common.Address, *types.Transaction, *WrappedNativeToken, error) {
+	address, tx, err := wrappedToken.Deploy(auth, backend, name, symbol)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	instance, err := NewWrappedNativeToken(address, backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, instance, nil
+}
+
+func runWrap(args []string) {
+	wrapNative("wrap", args, true)
+}
+
+func runUnwrap(args []string) {
+	wrapNative("unwrap", args, false)
+}
+
+// wrapNative implements the wrap and unwrap commands, which deposit native
+// currency into a token deployed with -wrapped and withdraw it again. Both
+// check afterwards that the balances moved by exactly the amount.
+func wrapNative(name string, args []string, wrap bool) {
+	label := "Wrap"
+	if !wrap {
+		label = "Unwrap"
+	}
+	fs := newFlagSet(name)
+	addRPCFlag(fs)
+	addTxFlags(fs)
+	contract := fs.String("contract", "", "Address of a token deployed with -wrapped")
+	amount := fs.String("amount", "", "Amount of native currency to "+name+", e.g. 0.05")
+	fs.Parse(args)
+
+	if (rpcURL == "" && networkName == "") || *contract == "" || *amount == "" {
+		fatal("All flags are required: -rpc (or -network), -contract, -amount")
+	}
+	if _, err := parseAddress(*contract); err != nil {
+		fatalf("Invalid contract address: %v", err)
+	}
+	// Wrapped tokens always have the 18 decimals of the native currency.
+	value, err := parseAmount(*amount, 18)
+	if err != nil {
+		fatalf("Failed to parse amount: %v", err)
+	}
+	if value.Sign() == 0 {
+		fatal("The -amount must be greater than zero")
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	account, err := loadSigner()
+	if err != nil {
+		fatalf("Failed to load signing account: %v", err)
+	}
+	defer account.Close()
+
+	client, err := dialClient(ctx)
+	if err != nil {
+		fatalf("Failed to connect to the Ethereum network: %v", err)
+	}
+	defer client.Close()
+
+	address := common.HexToAddress(*contract)
+	instance, err := NewWrappedNativeToken(address, client)
+	if err != nil {
+		fatalf("Failed to bind token contract: %v", err)
+	}
+
+	balance, err := instance.BalanceOf(&bind.CallOpts{Context: ctx}, account.address)
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	if !wrap && balance.Cmp(value) < 0 {
+		fatalfCode(exitFailure, "Cannot unwrap %s, the account only holds %s wrapped tokens", *amount, formatAmount(balance, 18))
+	}
+
+	auth, err := createTransactor(ctx, account, client)
+	if err != nil {
+		fatalf("Failed to create transactor: %v", err)
+	}
+
+	fmt.Println("Warning: wrapped tokens are backed 1:1 by the native currency held by the token contract.")
+	if err := confirmBroadcast(ctx, os.Stdout, client, name+" "+*amount+" native currency"); err != nil {
+		fatal(err)
+	}
+
+	var tx *types.Transaction
+	if wrap {
+		auth.Value = value
+		tx, err = instance.Deposit(auth)
+	} else {
+		tx, err = instance.Withdraw(auth, value)
+	}
+	if err != nil {
+		fatal(txError(name, err))
+	}
+	receipt, err := broadcastAndWait(ctx, client, tx, label)
+	if err != nil {
+		fatalf("%s failed: %v", label, err)
+	}
+	if receipt.Status != 1 {
+		return
+	}
+
+	// Compare the balances just before and at the block of the transaction
+	// so later transactions don't skew them.
+	before := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	tokensBefore, err := instance.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: before}, account.address)
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	tokensAfter, err := instance.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: receipt.BlockNumber}, account.address)
+	if err != nil {
+		fatalf("Failed to query balance: %v", err)
+	}
+	fmt.Printf("Wrapped balance: %s -> %s\n", formatAmount(tokensBefore, 18), formatAmount(tokensAfter, 18))
+	tokenDelta := new(big.Int).Sub(tokensAfter, tokensBefore)
+	if !wrap {
+		tokenDelta.Neg(tokenDelta)
+	}
+	if tokenDelta.Cmp(value) != 0 {
+		fatalfCode(exitFailure, "Wrapped balance changed by %s, expected %s", formatAmount(tokenDelta, 18), *amount)
+	}
+
+	nativeBefore, err := client.BalanceAt(ctx, account.address, before)
+	if err != nil {
+		fatalf("Failed to query native balance: %v", err)
+	}
+	nativeAfter, err := client.BalanceAt(ctx, account.address, receipt.BlockNumber)
+	if err != nil {
+		fatalf("Failed to query native balance: %v", err)
+	}
+	fmt.Printf("Native balance: %s -> %s\n", formatAmount(nativeBefore, 18), formatAmount(nativeAfter, 18))
+	// The native balance also paid for gas. Rollups charge an L1 fee on top
+	// that the receipt doesn't show, and other transactions of the account in
+	// the same block move it too, so a mismatch is only a warning.
+	nativeDelta := new(big.Int).Sub(nativeBefore, nativeAfter)
+	if receipt.EffectiveGasPrice != nil {
+		nativeDelta.Sub(nativeDelta, new(big.Int).Mul(receipt.EffectiveGasPrice, new(big.Int).SetUint64(receipt.GasUsed)))
+	}
+	if !wrap {
+		nativeDelta.Neg(nativeDelta)
+	}
+	if nativeDelta.Cmp(value) != 0 {
+		slog.Warn("Native balance did not change by the amount after gas", "changed", formatAmount(nativeDelta, 18), "expected", *amount)
+	}
+}
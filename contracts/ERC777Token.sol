@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+// ERC777 was removed in OpenZeppelin Contracts 5, so this contract builds
+// against the 4.9 release, installed next to 5 as @openzeppelin/contracts-v4.
+import "@openzeppelin/contracts-v4/token/ERC777/ERC777.sol";
+
+contract ERC777Token is ERC777 {
+    // ERC777 tokens always have 18 decimals. The ERC777 constructor registers
+    // the token with the ERC-1820 registry, so deploying it reverts on chains
+    // without the registry.
+    constructor(
+        string memory name,
+        string memory symbol,
+        uint256 initialSupply,
+        address[] memory defaultOperators
+    ) ERC777(name, symbol, defaultOperators) {
+        _mint(msg.sender, initialSupply, "", "");
+    }
+}
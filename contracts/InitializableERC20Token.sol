@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+import "@openzeppelin/contracts-upgradeable/token/ERC20/ERC20Upgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/proxy/utils/Initializable.sol";
+
+// InitializableERC20Token is the implementation behind EIP-1167 clones made
+// with -clone-of and transparent proxies made with -upgradeable. Clones and
+// proxies share its code but not its storage, so each one is set up by
+// calling initialize when it is created.
+contract InitializableERC20Token is Initializable, ERC20Upgradeable {
+    uint8 private _decimals;
+
+    constructor() {
+        // The implementation itself is never initialized, only its clones.
+        _disableInitializers();
+    }
+
+    function initialize(
+        string memory name,
+        string memory symbol,
+        uint8 decimals_,
+        uint256 initialSupply
+    ) external initializer {
+        __ERC20_init(name, symbol);
+        _decimals = decimals_;
+        _mint(msg.sender, initialSupply);
+    }
+
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+}
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+// Imported only so that Hardhat produces artifacts for the proxies deployed
+// by -upgradeable: TransparentUpgradeableProxy, which creates its own
+// ProxyAdmin, and ERC1967Proxy for UUPS implementations.
+import "@openzeppelin/contracts/proxy/transparent/TransparentUpgradeableProxy.sol";
+import "@openzeppelin/contracts/proxy/ERC1967/ERC1967Proxy.sol";
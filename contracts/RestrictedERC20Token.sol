@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+
+// RestrictedERC20Token lets its owner block addresses, which can then neither
+// send nor receive tokens, for compliance use cases. This is a central point
+// of control: the owner can freeze any holder's balance at will.
+contract RestrictedERC20Token is ERC20, Ownable {
+    uint8 private _decimals;
+    mapping(address => bool) private _blocked;
+
+    event AddressBlocked(address indexed account);
+    event AddressUnblocked(address indexed account);
+
+    error BlockedAddress(address account);
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        uint8 decimals_,
+        uint256 initialSupply
+    ) ERC20(name, symbol) Ownable(msg.sender) {
+        _decimals = decimals_;
+        _mint(msg.sender, initialSupply);
+    }
+
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+
+    function isBlocked(address account) public view returns (bool) {
+        return _blocked[account];
+    }
+
+    function blockAddress(address account) public onlyOwner {
+        _blocked[account] = true;
+        emit AddressBlocked(account);
+    }
+
+    function unblockAddress(address account) public onlyOwner {
+        _blocked[account] = false;
+        emit AddressUnblocked(account);
+    }
+
+    function _update(address from, address to, uint256 value) internal override {
+        if (_blocked[from]) {
+            revert BlockedAddress(from);
+        }
+        if (_blocked[to]) {
+            revert BlockedAddress(to);
+        }
+        super._update(from, to, value);
+    }
+}
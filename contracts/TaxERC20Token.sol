@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+import "@openzeppelin/contracts/access/Ownable.sol";
+
+// TaxERC20Token deducts taxBps basis points of every transfer and sends them
+// to the treasury. Mints, burns and transfers from or to the treasury are not
+// taxed. There is no exclusion list yet; exempting addresses such as DEX
+// pairs would mean an owner-managed mapping checked next to the treasury.
+contract TaxERC20Token is ERC20, Ownable {
+    uint16 public constant MAX_TAX_BPS = 1000;
+
+    uint8 private _decimals;
+    uint16 private _taxBps;
+    address private _treasury;
+
+    error TaxTooHigh(uint16 taxBps, uint16 maxTaxBps);
+    error InvalidTreasury();
+
+    constructor(
+        string memory name,
+        string memory symbol,
+        uint8 decimals_,
+        uint256 initialSupply,
+        uint16 taxBps_,
+        address treasury_
+    ) ERC20(name, symbol) Ownable(msg.sender) {
+        if (taxBps_ > MAX_TAX_BPS) {
+            revert TaxTooHigh(taxBps_, MAX_TAX_BPS);
+        }
+        if (taxBps_ > 0 && treasury_ == address(0)) {
+            revert InvalidTreasury();
+        }
+        _decimals = decimals_;
+        _taxBps = taxBps_;
+        _treasury = treasury_;
+        _mint(msg.sender, initialSupply);
+    }
+
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+
+    function taxBps() public view returns (uint16) {
+        return _taxBps;
+    }
+
+    function treasury() public view returns (address) {
+        return _treasury;
+    }
+
+    function _update(address from, address to, uint256 value) internal override {
+        if (_taxBps == 0 || from == address(0) || to == address(0) || from == _treasury || to == _treasury) {
+            super._update(from, to, value);
+            return;
+        }
+        uint256 tax = (value * _taxBps) / 10000;
+        super._update(from, _treasury, tax);
+        super._update(from, to, value - tax);
+    }
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+import "@openzeppelin/contracts-upgradeable/token/ERC20/ERC20Upgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/access/OwnableUpgradeable.sol";
+import "@openzeppelin/contracts-upgradeable/proxy/utils/Initializable.sol";
+import "@openzeppelin/contracts-upgradeable/proxy/utils/UUPSUpgradeable.sol";
+
+// UUPSERC20Token is the implementation behind -upgradeable -proxy-type uups
+// deployments. The upgrade logic lives here rather than in the proxy, and
+// only the owner, the -admin address, may upgrade.
+contract UUPSERC20Token is Initializable, ERC20Upgradeable, OwnableUpgradeable, UUPSUpgradeable {
+    uint8 private _decimals;
+
+    constructor() {
+        _disableInitializers();
+    }
+
+    function initialize(
+        string memory name,
+        string memory symbol,
+        uint8 decimals_,
+        uint256 initialSupply,
+        address initialOwner
+    ) external initializer {
+        __ERC20_init(name, symbol);
+        __Ownable_init(initialOwner);
+        __UUPSUpgradeable_init();
+        _decimals = decimals_;
+        _mint(msg.sender, initialSupply);
+    }
+
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+
+    function _authorizeUpgrade(address) internal override onlyOwner {}
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: UNLICENSED
+pragma solidity ^0.8.28;
+
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+
+// WrappedNativeToken wraps the chain's native currency 1:1, like WETH9: every
+// token is minted by depositing the same amount of native currency and
+// burned to withdraw it again. It has no owner and no initial supply.
+contract WrappedNativeToken is ERC20 {
+    event Deposit(address indexed account, uint256 value);
+    event Withdrawal(address indexed account, uint256 value);
+
+    error WithdrawFailed(address account, uint256 value);
+
+    constructor(string memory name, string memory symbol) ERC20(name, symbol) {}
+
+    receive() external payable {
+        deposit();
+    }
+
+    fallback() external payable {
+        deposit();
+    }
+
+    function deposit() public payable {
+        _mint(msg.sender, msg.value);
+        emit Deposit(msg.sender, msg.value);
+    }
+
+    function withdraw(uint256 value) public {
+        _burn(msg.sender, value);
+        emit Withdrawal(msg.sender, value);
+        (bool ok, ) = msg.sender.call{value: value}("");
+        if (!ok) {
+            revert WithdrawFailed(msg.sender, value);
+        }
+    }
+}
@@ -0,0 +1,11 @@
+// Package contracts holds the Hardhat artifacts of the contracts the erc20
+// command deploys, built from the Solidity sources next to it with
+// npm run build, so that the command deploys them from any directory.
+package contracts
+
+import "embed"
+
+// Artifacts holds artifacts/<name>.json for every contract built.
+//
+//go:embed artifacts/*.json
+var Artifacts embed.FS
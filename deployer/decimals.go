@@ -0,0 +1,51 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DecimalsCache reads the decimals of tokens on one network and remembers
+// them, so that code handling the same token repeatedly asks the node only
+// once. The cache lives in memory only: a token at the same address on
+// another network may have other decimals, so use one DecimalsCache per
+// client. A DecimalsCache is safe for concurrent use.
+type DecimalsCache struct {
+	caller bind.ContractCaller
+
+	mu       sync.Mutex
+	decimals map[common.Address]uint8
+}
+
+// NewDecimalsCache returns an empty DecimalsCache reading through caller.
+func NewDecimalsCache(caller bind.ContractCaller) *DecimalsCache {
+	return &DecimalsCache{caller: caller, decimals: make(map[common.Address]uint8)}
+}
+
+// Decimals returns the decimals of token, reading them from the node the
+// first time. Failed reads are not remembered.
+func (c *DecimalsCache) Decimals(ctx context.Context, token common.Address) (uint8, error) {
+	c.mu.Lock()
+	decimals, ok := c.decimals[token]
+	c.mu.Unlock()
+	if ok {
+		return decimals, nil
+	}
+
+	instance, err := NewERC20TokenCaller(token, c.caller)
+	if err != nil {
+		return 0, err
+	}
+	decimals, err = instance.Decimals(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrRPC, err)
+	}
+	c.mu.Lock()
+	c.decimals[token] = decimals
+	c.mu.Unlock()
+	return decimals, nil
+}
@@ -0,0 +1,163 @@
+package deployer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Params are the constructor arguments of the token.
+type Params struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+	// Supply is the initial supply in base units, minted to the deployer.
+	Supply *big.Int
+}
+
+// Result is the outcome of a deployment.
+type Result struct {
+	Address     common.Address
+	Transaction *types.Transaction
+	Receipt     *types.Receipt // nil until the deployment is mined
+}
+
+// ParseKey parses a hex-encoded private key, with or without 0x prefix.
+func ParseKey(hexKey string) (*ecdsa.PrivateKey, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	return key, nil
+}
+
+// Backend is the node a Deployer deploys through. An *ethclient.Client is
+// one.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Deploy deploys the standard token with params from the account of key and
+// waits for the deployment to be mined. Fees are those suggested by the node.
+func Deploy(ctx context.Context, client Backend, key *ecdsa.PrivateKey, params Params) (*Result, error) {
+	if key == nil {
+		return nil, fmt.Errorf("%w: no key given", ErrInvalidKey)
+	}
+	auth, err := NewTransactor(ctx, client, TransactorConfig{
+		Signer: func(chainID *big.Int) (*bind.TransactOpts, error) {
+			return bind.NewKeyedTransactorWithChainID(key, chainID)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	d := &Deployer{Client: client, Transactor: auth, Params: params}
+	result, err := d.Deploy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.Receipt, err = d.Wait(ctx, result.Transaction); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Deployer deploys the standard token with Params from the account of
+// Transactor, as returned by NewTransactor.
+type Deployer struct {
+	Client     Backend
+	Transactor *bind.TransactOpts
+	Params     Params
+	// Nonces, if set, provides the nonce instead of Transactor. Share one
+	// NonceManager for the account between Deployers, or with other code
+	// sending from it, to send several transactions in a row.
+	Nonces *NonceManager
+}
+
+// Deploy signs the deployment, checks that the account can pay for it and
+// sends it, advancing the nonce of the transactor or of Nonces. The token
+// exists at the returned address once Wait returns; until then the result
+// has no receipt.
+func (d *Deployer) Deploy(ctx context.Context) (*Result, error) {
+	p := d.Params
+	if err := ValidateParams(p.Name, p.Symbol, uint(p.Decimals), p.Supply, true); err != nil {
+		return nil, err
+	}
+
+	if d.Nonces != nil {
+		var result *Result
+		err := d.Nonces.Send(ctx, func(nonce uint64) error {
+			var err error
+			result, err = d.send(ctx, new(big.Int).SetUint64(nonce))
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	result, err := d.send(ctx, d.Transactor.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	if d.Transactor.Nonce != nil {
+		d.Transactor.Nonce = new(big.Int).Add(d.Transactor.Nonce, big.NewInt(1))
+	}
+	return result, nil
+}
+
+// send signs the deployment with nonce, or the pending nonce if nil, and
+// sends it once the balance covers its cost.
+func (d *Deployer) send(ctx context.Context, nonce *big.Int) (*Result, error) {
+	p := d.Params
+	// Sign only, so the cost can be checked against the balance first.
+	auth := *d.Transactor
+	auth.Context = ctx
+	auth.Nonce = nonce
+	auth.NoSend = true
+	address, tx, _, err := DeployERC20Token(&auth, d.Client, p.Name, p.Symbol, p.Decimals, p.Supply)
+	if err != nil {
+		// Gas estimation already fails when the account cannot pay for it.
+		if strings.Contains(err.Error(), "insufficient funds") {
+			return nil, fmt.Errorf("%w: %w", ErrInsufficientFunds, err)
+		}
+		return nil, fmt.Errorf("%w: failed to build deployment: %w", ErrRPC, err)
+	}
+
+	balance, err := d.Client.BalanceAt(ctx, auth.From, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get balance: %w", ErrRPC, err)
+	}
+	if cost := tx.Cost(); balance.Cmp(cost) < 0 {
+		return nil, fmt.Errorf("%w: need %s wei, have %s wei", ErrInsufficientFunds, cost, balance)
+	}
+
+	if err := d.Client.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("%w: failed to send deployment: %w", ErrRPC, err)
+	}
+	return &Result{Address: address, Transaction: tx}, nil
+}
+
+// Wait waits for tx to be mined and returns its receipt. A reverted
+// deployment returns the receipt together with an error wrapping
+// ErrDeployReverted.
+func (d *Deployer) Wait(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, d.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for deployment %s: %w", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%w: transaction %s", ErrDeployReverted, tx.Hash().Hex())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,220 @@
+package deployer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// fakeBackend is a Backend on top of fakeNode that records the transactions
+// sent to it and mines them with status.
+type fakeBackend struct {
+	*fakeNode
+	balance *big.Int
+	status  uint64
+	sent    []*types.Transaction
+}
+
+func (b *fakeBackend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return b.balance, nil
+}
+
+func (b *fakeBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x60}, nil
+}
+
+func (b *fakeBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *fakeBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return nil, nil
+}
+
+func (b *fakeBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 1_000_000, nil
+}
+
+func (b *fakeBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	b.sent = append(b.sent, tx)
+	return nil
+}
+
+func (b *fakeBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(quit <-chan struct{}) error { <-quit; return nil }), nil
+}
+
+func (b *fakeBackend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	for _, tx := range b.sent {
+		if tx.Hash() == hash {
+			return &types.Receipt{Status: b.status, TxHash: hash, BlockNumber: big.NewInt(2)}, nil
+		}
+	}
+	return nil, ethereum.NotFound
+}
+
+func newFakeBackend(baseFee *big.Int) *fakeBackend {
+	return &fakeBackend{
+		fakeNode: &fakeNode{
+			chainID:  big.NewInt(1337),
+			baseFee:  baseFee,
+			tip:      big.NewInt(2_000_000_000),
+			gasPrice: big.NewInt(3_000_000_000),
+		},
+		balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil),
+		status:  types.ReceiptStatusSuccessful,
+	}
+}
+
+func testParams() Params {
+	return Params{Name: "My Token", Symbol: "MTK", Decimals: 18, Supply: big.NewInt(1_000_000)}
+}
+
+func TestDeployerTxTypes(t *testing.T) {
+	baseFee := big.NewInt(1_000_000_000)
+	tests := []struct {
+		name     string
+		txType   TxType
+		baseFee  *big.Int
+		wantType uint8
+	}{
+		{name: "auto with base fee", txType: TxTypeAuto, baseFee: baseFee, wantType: types.DynamicFeeTxType},
+		{name: "auto without base fee", txType: TxTypeAuto, wantType: types.LegacyTxType},
+		{name: "legacy with base fee", txType: TxTypeLegacy, baseFee: baseFee, wantType: types.LegacyTxType},
+		{name: "legacy without base fee", txType: TxTypeLegacy, wantType: types.LegacyTxType},
+		{name: "dynamic with base fee", txType: TxTypeDynamic, baseFee: baseFee, wantType: types.DynamicFeeTxType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := newFakeBackend(tt.baseFee)
+			cfg := keyedConfig(newTestKey(t))
+			cfg.TxType = tt.txType
+			auth, err := NewTransactor(context.Background(), backend, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &Deployer{Client: backend, Transactor: auth, Params: testParams()}
+			result, err := d.Deploy(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(backend.sent) != 1 || backend.sent[0] != result.Transaction {
+				t.Fatalf("sent %d transactions, want the deployment only", len(backend.sent))
+			}
+
+			tx := result.Transaction
+			if tx.Type() != tt.wantType {
+				t.Errorf("transaction type = %d, want %d", tx.Type(), tt.wantType)
+			}
+			if tx.ChainId().Cmp(backend.chainID) != 0 {
+				t.Errorf("chain ID = %s, want %s", tx.ChainId(), backend.chainID)
+			}
+			if tt.wantType == types.LegacyTxType {
+				if tx.GasPrice().Cmp(backend.gasPrice) != 0 {
+					t.Errorf("gas price = %s, want the suggested %s", tx.GasPrice(), backend.gasPrice)
+				}
+			} else {
+				// Twice the base fee plus the tip.
+				wantFeeCap := big.NewInt(4_000_000_000)
+				if tx.GasTipCap().Cmp(backend.tip) != 0 || tx.GasFeeCap().Cmp(wantFeeCap) != 0 {
+					t.Errorf("fees = %s tip, %s cap, want %s, %s", tx.GasTipCap(), tx.GasFeeCap(), backend.tip, wantFeeCap)
+				}
+			}
+			if want := crypto.CreateAddress(auth.From, 0); result.Address != want {
+				t.Errorf("address = %s, want %s", result.Address, want)
+			}
+		})
+	}
+}
+
+func TestNewTransactorDynamicWithoutBaseFee(t *testing.T) {
+	cfg := keyedConfig(newTestKey(t))
+	cfg.TxType = TxTypeDynamic
+	if _, err := NewTransactor(context.Background(), newFakeBackend(nil), cfg); !errors.Is(err, ErrNoDynamicFees) {
+		t.Errorf("NewTransactor() = %v, want ErrNoDynamicFees", err)
+	}
+}
+
+func TestDeployerInsufficientFunds(t *testing.T) {
+	backend := newFakeBackend(big.NewInt(1_000_000_000))
+	backend.balance = big.NewInt(1)
+	auth, err := NewTransactor(context.Background(), backend, keyedConfig(newTestKey(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &Deployer{Client: backend, Transactor: auth, Params: testParams()}
+	if _, err := d.Deploy(context.Background()); !errors.Is(err, ErrInsufficientFunds) {
+		t.Errorf("Deploy() = %v, want ErrInsufficientFunds", err)
+	}
+	if len(backend.sent) != 0 {
+		t.Errorf("sent %d transactions, want none", len(backend.sent))
+	}
+}
+
+func TestDeployerNonces(t *testing.T) {
+	backend := newFakeBackend(nil)
+	backend.nonce = 4
+	auth, err := NewTransactor(context.Background(), backend, keyedConfig(newTestKey(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonces := NewNonceManager(backend, auth.From)
+	for want := uint64(4); want < 6; want++ {
+		d := &Deployer{Client: backend, Transactor: auth, Params: testParams(), Nonces: nonces}
+		result, err := d.Deploy(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Transaction.Nonce() != want {
+			t.Errorf("nonce = %d, want %d", result.Transaction.Nonce(), want)
+		}
+	}
+}
+
+func TestDeployerWait(t *testing.T) {
+	for _, status := range []uint64{types.ReceiptStatusSuccessful, types.ReceiptStatusFailed} {
+		backend := newFakeBackend(nil)
+		backend.status = status
+		auth, err := NewTransactor(context.Background(), backend, keyedConfig(newTestKey(t)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := &Deployer{Client: backend, Transactor: auth, Params: testParams()}
+		result, err := d.Deploy(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt, err := d.Wait(context.Background(), result.Transaction)
+		if receipt == nil || receipt.Status != status {
+			t.Fatalf("Wait() = %v, want a receipt with status %d", receipt, status)
+		}
+		if reverted := errors.Is(err, ErrDeployReverted); reverted != (status == types.ReceiptStatusFailed) {
+			t.Errorf("Wait() with status %d = %v", status, err)
+		}
+	}
+}
+
+func TestDeployerInvalidParams(t *testing.T) {
+	backend := newFakeBackend(nil)
+	auth, err := NewTransactor(context.Background(), backend, keyedConfig(newTestKey(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := testParams()
+	params.Symbol = ""
+	d := &Deployer{Client: backend, Transactor: auth, Params: params}
+	if _, err := d.Deploy(context.Background()); !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("Deploy() = %v, want ErrInvalidParams", err)
+	}
+}
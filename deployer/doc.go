@@ -0,0 +1,9 @@
+// Package deployer deploys the standard ERC20 token of the erc20 command.
+// Unlike the command it never exits the process: every failure is returned
+// as an error wrapping one of the Err values of this package and its cause.
+//
+// A program embedding the deployer builds transact options with
+// NewTransactor and hands them to a Deployer together with the token
+// parameters, as the Deployer example shows. The Deploy function does all
+// of this for a private key with the fees the node suggests.
+package deployer
@@ -0,0 +1,26 @@
+package deployer
+
+import "errors"
+
+// Errors returned by this package wrap one of these, together with the
+// underlying cause, so callers can tell failures apart with errors.Is.
+var (
+	// ErrInvalidKey is returned for a private key that cannot be parsed.
+	ErrInvalidKey = errors.New("invalid private key")
+	// ErrInvalidParams is returned for token parameters that fail validation.
+	ErrInvalidParams = errors.New("invalid token parameters")
+	// ErrInsufficientFunds is returned when the deployer cannot pay for the
+	// gas of the deployment.
+	ErrInsufficientFunds = errors.New("insufficient funds for gas")
+	// ErrRPC is returned when a request to the node fails.
+	ErrRPC = errors.New("RPC request failed")
+	// ErrNoReplayProtection is returned by NewTransactor on a chain whose
+	// transactions would carry no EIP-155 replay protection.
+	ErrNoReplayProtection = errors.New("no EIP-155 replay protection")
+	// ErrNoDynamicFees is returned by NewTransactor when dynamic fee
+	// transactions are asked for on a chain without EIP-1559.
+	ErrNoDynamicFees = errors.New("EIP-1559 not supported")
+	// ErrDeployReverted is returned when the deployment transaction is mined
+	// but reverted.
+	ErrDeployReverted = errors.New("deployment reverted")
+)
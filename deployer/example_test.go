@@ -0,0 +1,80 @@
+package deployer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/devlongs/erc20-cli/deployer"
+)
+
+// A program embedding the deployer builds transact options with
+// NewTransactor and hands them to a Deployer together with the token
+// parameters.
+func ExampleDeployer() {
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, "https://rpc.sepolia.org")
+	if err != nil {
+		log.Fatal(err)
+	}
+	key, err := deployer.ParseKey(os.Getenv("TOKKEN_PRIVATE_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	auth, err := deployer.NewTransactor(ctx, client, deployer.TransactorConfig{
+		Signer: func(chainID *big.Int) (*bind.TransactOpts, error) {
+			return bind.NewKeyedTransactorWithChainID(key, chainID)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	supply, err := deployer.ParseSupply("1_000_000", 18)
+	if err != nil {
+		log.Fatal(err)
+	}
+	d := &deployer.Deployer{
+		Client:     client,
+		Transactor: auth,
+		Params:     deployer.Params{Name: "My Token", Symbol: "MTK", Decimals: 18, Supply: supply},
+	}
+	result, err := d.Deploy(ctx)
+	if errors.Is(err, deployer.ErrInsufficientFunds) {
+		log.Fatalf("fund %s first: %v", auth.From, err)
+	} else if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := d.Wait(ctx, result.Transaction); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Token deployed at", result.Address)
+}
+
+// Deploy does everything of the Deployer example for a private key, with the
+// fees the node suggests.
+func ExampleDeploy() {
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, "https://rpc.sepolia.org")
+	if err != nil {
+		log.Fatal(err)
+	}
+	key, err := deployer.ParseKey(os.Getenv("TOKKEN_PRIVATE_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	supply, err := deployer.ParseSupply("1_000_000", 18)
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := deployer.Deploy(ctx, client, key, deployer.Params{Name: "My Token", Symbol: "MTK", Decimals: 18, Supply: supply})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Token deployed at", result.Address)
+}
@@ -0,0 +1,85 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendingNonceReader reads the next nonce of an account, counting the
+// transactions in the node's mempool. An *ethclient.Client is one.
+type PendingNonceReader interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out the nonces of an account from a local counter. The
+// pending nonce is fetched from the node once and then incremented for every
+// transaction sent, so back-to-back transactions neither wait for a round
+// trip nor depend on the node having seen the previous one yet. A
+// NonceManager is safe for concurrent use; sends are serialized.
+type NonceManager struct {
+	client  PendingNonceReader
+	account common.Address
+
+	mu     sync.Mutex
+	next   uint64
+	synced bool
+}
+
+// NewNonceManager returns a NonceManager for account that fetches the
+// pending nonce from client on first use.
+func NewNonceManager(client PendingNonceReader, account common.Address) *NonceManager {
+	return &NonceManager{client: client, account: account}
+}
+
+// Set makes nonce the next one handed out, e.g. to continue from a nonce
+// chosen by the user.
+func (m *NonceManager) Set(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.next, m.synced = nonce, true
+}
+
+// Reset drops the local nonce, so the next Send fetches it from the node.
+func (m *NonceManager) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.synced = false
+}
+
+// Send calls send with the next nonce, which is used up when send returns
+// nil. If the node rejects the transaction with "nonce too low", because the
+// account sent transactions the manager did not hand out or a stale nonce was
+// set, the pending nonce is fetched again and send is called once more with
+// it. send must therefore sign the transaction anew for the nonce it gets.
+func (m *NonceManager) Send(ctx context.Context, send func(nonce uint64) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for retried := false; ; retried = true {
+		if !m.synced {
+			pending, err := m.client.PendingNonceAt(ctx, m.account)
+			if err != nil {
+				return fmt.Errorf("%w: failed to get nonce: %w", ErrRPC, err)
+			}
+			m.next, m.synced = pending, true
+		}
+		err := send(m.next)
+		if err == nil {
+			m.next++
+			return nil
+		}
+		if retried || !IsNonceTooLow(err) {
+			return err
+		}
+		m.synced = false
+	}
+}
+
+// IsNonceTooLow reports whether err is the node rejecting a transaction
+// whose nonce the account already used.
+func IsNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "nonce too low")
+}
@@ -0,0 +1,159 @@
+package deployer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// fakeNonces is a PendingNonceReader returning pending and counting how
+// often it was asked.
+type fakeNonces struct {
+	pending uint64
+	err     error
+	calls   int
+}
+
+func (f *fakeNonces) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	f.calls++
+	return f.pending, f.err
+}
+
+func TestNonceManagerSend(t *testing.T) {
+	node := &fakeNonces{pending: 7}
+	m := NewNonceManager(node, common.Address{})
+
+	var got []uint64
+	for i := 0; i < 3; i++ {
+		err := m.Send(context.Background(), func(nonce uint64) error {
+			got = append(got, nonce)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := []uint64{7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("nonces = %v, want %v", got, want)
+	}
+	if node.calls != 1 {
+		t.Errorf("node asked %d times, want once", node.calls)
+	}
+}
+
+func TestNonceManagerFailedSendKeepsNonce(t *testing.T) {
+	m := NewNonceManager(&fakeNonces{pending: 3}, common.Address{})
+	failure := errors.New("replacement transaction underpriced")
+
+	var got []uint64
+	send := func(err error) func(uint64) error {
+		return func(nonce uint64) error {
+			got = append(got, nonce)
+			return err
+		}
+	}
+	if err := m.Send(context.Background(), send(failure)); !errors.Is(err, failure) {
+		t.Fatalf("Send() = %v, want %v", err, failure)
+	}
+	if err := m.Send(context.Background(), send(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{3, 3}; !slices.Equal(got, want) {
+		t.Errorf("nonces = %v, want %v", got, want)
+	}
+}
+
+func TestNonceManagerRetriesNonceTooLow(t *testing.T) {
+	node := &fakeNonces{pending: 5}
+	m := NewNonceManager(node, common.Address{})
+	m.Set(2) // stale, the account already sent 2 to 4 elsewhere
+
+	var got []uint64
+	err := m.Send(context.Background(), func(nonce uint64) error {
+		got = append(got, nonce)
+		if nonce < node.pending {
+			return errors.New("nonce too low: next nonce 5, tx nonce 2")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{2, 5}; !slices.Equal(got, want) {
+		t.Errorf("nonces = %v, want %v", got, want)
+	}
+}
+
+func TestNonceManagerRetriesOnce(t *testing.T) {
+	m := NewNonceManager(&fakeNonces{pending: 5}, common.Address{})
+	calls := 0
+	err := m.Send(context.Background(), func(nonce uint64) error {
+		calls++
+		return errors.New("nonce too low")
+	})
+	if !IsNonceTooLow(err) {
+		t.Errorf("Send() = %v, want nonce too low", err)
+	}
+	if calls != 2 {
+		t.Errorf("send called %d times, want 2", calls)
+	}
+}
+
+func TestNonceManagerReset(t *testing.T) {
+	node := &fakeNonces{pending: 1}
+	m := NewNonceManager(node, common.Address{})
+	m.Set(10)
+	m.Reset()
+	var got uint64
+	if err := m.Send(context.Background(), func(nonce uint64) error { got = nonce; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if got != 1 {
+		t.Errorf("nonce after Reset = %d, want the pending nonce 1", got)
+	}
+}
+
+func TestNonceManagerRPCError(t *testing.T) {
+	m := NewNonceManager(&fakeNonces{err: errors.New("connection refused")}, common.Address{})
+	err := m.Send(context.Background(), func(nonce uint64) error {
+		t.Fatal("send called without a nonce")
+		return nil
+	})
+	if !errors.Is(err, ErrRPC) {
+		t.Errorf("Send() = %v, want ErrRPC", err)
+	}
+}
+
+func TestNonceManagerConcurrent(t *testing.T) {
+	m := NewNonceManager(&fakeNonces{}, common.Address{})
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = map[uint64]bool{}
+	)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Send(context.Background(), func(nonce uint64) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if seen[nonce] {
+					t.Errorf("nonce %d handed out twice", nonce)
+				}
+				seen[nonce] = true
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	for n := uint64(0); n < 50; n++ {
+		if !seen[n] {
+			t.Errorf("nonce %d never handed out", n)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package deployer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransactorBackend is the part of a node NewTransactor asks for the chain
+// ID, nonce and fees. An *ethclient.Client is one.
+type TransactorBackend interface {
+	PendingNonceReader
+	ChainID(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// TxType selects the kind of transactions NewTransactor prepares.
+type TxType int
+
+const (
+	// TxTypeAuto sends EIP-1559 dynamic fee transactions when the latest
+	// block has a base fee and legacy ones otherwise.
+	TxTypeAuto TxType = iota
+	// TxTypeLegacy sends legacy transactions with a gas price, e.g. to an
+	// endpoint that reports a base fee but rejects dynamic fee transactions.
+	TxTypeLegacy
+	// TxTypeDynamic sends EIP-1559 dynamic fee transactions and fails on a
+	// chain without a base fee.
+	TxTypeDynamic
+)
+
+// TransactorConfig configures the transact options built by NewTransactor.
+// Only Signer is required; every other field falls back to what the node
+// suggests.
+type TransactorConfig struct {
+	// Signer returns transact options signing for the sending account on
+	// chainID, e.g. a closure around bind.NewKeyedTransactorWithChainID.
+	Signer func(chainID *big.Int) (*bind.TransactOpts, error)
+	// Nonce is the nonce of the first transaction, nil for the next pending
+	// nonce of the account.
+	Nonce *uint64
+	// GasLimit is the gas limit of the transactions, 0 to let the bindings
+	// estimate it.
+	GasLimit uint64
+	// GasPrice is the gas price on chains without EIP-1559.
+	GasPrice *big.Int
+	// MaxFee and PriorityFee are the EIP-1559 fee caps. The max fee defaults
+	// to twice the base fee plus the priority fee.
+	MaxFee      *big.Int
+	PriorityFee *big.Int
+	// Fees, if set, is asked for the priority fee and base fee before the
+	// node is. Returning a nil tip falls back to the node's suggestion.
+	Fees func(ctx context.Context) (tip, baseFee *big.Int, err error)
+	// Retry, if set, makes every request to the node, e.g. to retry
+	// transient failures. op names the request.
+	Retry func(ctx context.Context, op string, fn func() error) error
+	// AllowUnprotected lets NewTransactor sign on a chain reporting chain ID
+	// 0. Such a chain predates EIP-155, so its transactions can be replayed
+	// on any other chain without replay protection.
+	AllowUnprotected bool
+	// TxType forces legacy or dynamic fee transactions instead of choosing
+	// by the base fee of the latest block.
+	TxType TxType
+}
+
+// call makes an RPC request through c.Retry.
+func (c *TransactorConfig) call(ctx context.Context, op string, fn func() error) error {
+	if c.Retry == nil {
+		return fn()
+	}
+	return c.Retry(ctx, op, fn)
+}
+
+// NewTransactor returns transact options for the account of cfg.Signer on
+// the chain of client, with the nonce and fees filled in. The options only
+// sign: callers broadcast the transactions the bindings return themselves,
+// so failed sends can be retried without re-signing.
+func NewTransactor(ctx context.Context, client TransactorBackend, cfg TransactorConfig) (*bind.TransactOpts, error) {
+	var chainID *big.Int
+	err := cfg.call(ctx, "get chain ID", func() (err error) {
+		chainID, err = client.ChainID(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get chain ID: %w", ErrRPC, err)
+	}
+	if chainID.Sign() == 0 && !cfg.AllowUnprotected {
+		return nil, fmt.Errorf("%w: the node reports chain ID 0, transactions signed for it could be replayed on other chains", ErrNoReplayProtection)
+	}
+
+	auth, err := cfg.Signer(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up signer: %w", err)
+	}
+
+	var nonce uint64
+	if cfg.Nonce != nil {
+		nonce = *cfg.Nonce
+	} else {
+		err := cfg.call(ctx, "get nonce", func() (err error) {
+			nonce, err = client.PendingNonceAt(ctx, auth.From)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to get nonce: %w", ErrRPC, err)
+		}
+	}
+
+	auth.Nonce = new(big.Int).SetUint64(nonce)
+	auth.Context = ctx
+	auth.Value = big.NewInt(0)
+	auth.NoSend = true
+	auth.GasLimit = cfg.GasLimit
+
+	var header *types.Header
+	err = cfg.call(ctx, "get latest header", func() (err error) {
+		header, err = client.HeaderByNumber(ctx, nil)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get latest header: %w", ErrRPC, err)
+	}
+
+	switch {
+	case cfg.TxType == TxTypeDynamic && header.BaseFee == nil:
+		return nil, fmt.Errorf("%w: the latest block has no base fee", ErrNoDynamicFees)
+	case cfg.TxType == TxTypeLegacy || header.BaseFee == nil:
+		err = setLegacyGasPrice(ctx, auth, client, &cfg)
+	default:
+		err = setDynamicFees(ctx, auth, client, &cfg, header.BaseFee)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return auth, nil
+}
+
+// setDynamicFees populates the EIP-1559 fee caps, leaving GasPrice nil so the
+// bound contract builds a dynamic fee transaction.
+func setDynamicFees(ctx context.Context, auth *bind.TransactOpts, client TransactorBackend, cfg *TransactorConfig, baseFee *big.Int) error {
+	var suggestedTip *big.Int
+	if cfg.Fees != nil {
+		tip, nextBaseFee, err := cfg.Fees(ctx)
+		if err != nil {
+			return err
+		}
+		if tip != nil {
+			suggestedTip, baseFee = tip, nextBaseFee
+		}
+	}
+
+	switch {
+	case cfg.PriorityFee != nil:
+		auth.GasTipCap = new(big.Int).Set(cfg.PriorityFee)
+	case suggestedTip != nil:
+		auth.GasTipCap = suggestedTip
+	default:
+		err := cfg.call(ctx, "suggest gas tip cap", func() (err error) {
+			auth.GasTipCap, err = client.SuggestGasTipCap(ctx)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("%w: failed to suggest gas tip cap: %w", ErrRPC, err)
+		}
+	}
+
+	if cfg.MaxFee != nil {
+		auth.GasFeeCap = new(big.Int).Set(cfg.MaxFee)
+	} else {
+		auth.GasFeeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), auth.GasTipCap)
+	}
+
+	if auth.GasFeeCap.Cmp(auth.GasTipCap) < 0 {
+		return fmt.Errorf("max fee (%s wei) is lower than priority fee (%s wei)", auth.GasFeeCap, auth.GasTipCap)
+	}
+	return nil
+}
+
+// setLegacyGasPrice populates GasPrice for chains that do not support EIP-1559.
+func setLegacyGasPrice(ctx context.Context, auth *bind.TransactOpts, client TransactorBackend, cfg *TransactorConfig) error {
+	if cfg.GasPrice != nil {
+		auth.GasPrice = new(big.Int).Set(cfg.GasPrice)
+		return nil
+	}
+	err := cfg.call(ctx, "suggest gas price", func() (err error) {
+		auth.GasPrice, err = client.SuggestGasPrice(ctx)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to suggest gas price: %w", ErrRPC, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package deployer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeNode is a TransactorBackend answering with fixed values. A nil
+// baseFee makes the latest block one from before EIP-1559.
+type fakeNode struct {
+	chainID  *big.Int
+	nonce    uint64
+	baseFee  *big.Int
+	tip      *big.Int
+	gasPrice *big.Int
+	err      error
+}
+
+func (n *fakeNode) ChainID(ctx context.Context) (*big.Int, error) {
+	return n.chainID, n.err
+}
+
+func (n *fakeNode) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return n.nonce, n.err
+}
+
+func (n *fakeNode) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: n.baseFee}, n.err
+}
+
+func (n *fakeNode) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return n.tip, n.err
+}
+
+func (n *fakeNode) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return n.gasPrice, n.err
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func keyedConfig(key *ecdsa.PrivateKey) TransactorConfig {
+	return TransactorConfig{
+		Signer: func(chainID *big.Int) (*bind.TransactOpts, error) {
+			return bind.NewKeyedTransactorWithChainID(key, chainID)
+		},
+	}
+}
+
+func TestNewTransactorRejectsChainIDZero(t *testing.T) {
+	node := &fakeNode{chainID: big.NewInt(0), gasPrice: big.NewInt(1)}
+	signed := false
+	cfg := TransactorConfig{
+		Signer: func(chainID *big.Int) (*bind.TransactOpts, error) {
+			signed = true
+			return nil, errors.New("unexpected")
+		},
+	}
+	_, err := NewTransactor(context.Background(), node, cfg)
+	if !errors.Is(err, ErrNoReplayProtection) {
+		t.Fatalf("NewTransactor() = %v, want ErrNoReplayProtection", err)
+	}
+	if signed {
+		t.Error("NewTransactor() set up the signer for chain ID 0")
+	}
+}
+
+func TestNewTransactorAllowUnprotected(t *testing.T) {
+	node := &fakeNode{chainID: big.NewInt(0), gasPrice: big.NewInt(1)}
+	cfg := keyedConfig(newTestKey(t))
+	cfg.AllowUnprotected = true
+	if _, err := NewTransactor(context.Background(), node, cfg); err != nil {
+		t.Fatalf("NewTransactor() with AllowUnprotected = %v, want nil", err)
+	}
+}
+
+func TestNewTransactorNonce(t *testing.T) {
+	node := &fakeNode{chainID: big.NewInt(1337), nonce: 9, gasPrice: big.NewInt(1)}
+	key := newTestKey(t)
+
+	auth, err := NewTransactor(context.Background(), node, keyedConfig(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.Nonce.Uint64() != 9 {
+		t.Errorf("nonce = %d, want the pending nonce 9", auth.Nonce)
+	}
+	if auth.From != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Errorf("from = %s, want the address of the key", auth.From)
+	}
+	if !auth.NoSend {
+		t.Error("NoSend = false, want the options to sign only")
+	}
+
+	cfg := keyedConfig(key)
+	nonce := uint64(3)
+	cfg.Nonce = &nonce
+	if auth, err = NewTransactor(context.Background(), node, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if auth.Nonce.Uint64() != 3 {
+		t.Errorf("nonce = %d, want the configured nonce 3", auth.Nonce)
+	}
+}
+
+func TestNewTransactorRPCError(t *testing.T) {
+	node := &fakeNode{err: errors.New("connection refused")}
+	if _, err := NewTransactor(context.Background(), node, keyedConfig(newTestKey(t))); !errors.Is(err, ErrRPC) {
+		t.Errorf("NewTransactor() = %v, want ErrRPC", err)
+	}
+}
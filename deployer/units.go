@@ -0,0 +1,75 @@
+package deployer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseSupply converts a token supply in whole units, such as "1_000_000" or
+// "0.25", to base units. A supply that does not fit in a uint256 at decimals
+// is rejected with an error wrapping ErrInvalidParams.
+func ParseSupply(supply string, decimals uint8) (*big.Int, error) {
+	value, err := ParseUnits(supply, int(decimals))
+	if err != nil {
+		return nil, fmt.Errorf("invalid supply value: %s: %w", supply, err)
+	}
+	if err := checkUint256(value, decimals); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// maxUint256 is the largest value a uint256 holds, 2^256-1.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// checkUint256 rejects a supply in base units that exceeds a uint256, which
+// the token's constructor could not take.
+func checkUint256(supply *big.Int, decimals uint8) error {
+	if supply.Cmp(maxUint256) <= 0 {
+		return nil
+	}
+	largest := new(big.Int).Quo(maxUint256, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	return fmt.Errorf("%w: supply too large for uint256 at %d decimals, at most %s whole tokens fit", ErrInvalidParams, decimals, new(big.Float).SetInt(largest).Text('g', 3))
+}
+
+// ParseUnits parses a non-negative decimal number and scales it by
+// 10^decimals exactly. Underscores may separate digits for readability, and
+// more fractional digits than decimals are rejected rather than rounded.
+func ParseUnits(value string, decimals int) (*big.Int, error) {
+	for i, c := range value {
+		if c != '_' {
+			continue
+		}
+		if i == 0 || i == len(value)-1 || !isDigit(value[i-1]) || !isDigit(value[i+1]) {
+			return nil, fmt.Errorf("underscores must separate digits")
+		}
+	}
+	digits := strings.ReplaceAll(value, "_", "")
+
+	whole, frac, _ := strings.Cut(digits, ".")
+	if whole == "" && frac == "" {
+		return nil, fmt.Errorf("not a number")
+	}
+	for _, part := range []string{whole, frac} {
+		for i := 0; i < len(part); i++ {
+			if !isDigit(part[i]) {
+				return nil, fmt.Errorf("not a non-negative decimal number")
+			}
+		}
+	}
+	if len(frac) > decimals {
+		return nil, fmt.Errorf("%d fractional digits given but only %d decimals are supported", len(frac), decimals)
+	}
+
+	// Pad the fraction to exactly decimals digits and read the whole number.
+	scaled, ok := new(big.Int).SetString(whole+frac+strings.Repeat("0", decimals-len(frac)), 10)
+	if !ok {
+		return nil, fmt.Errorf("not a number")
+	}
+	return scaled, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,111 @@
+package deployer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		want     string // base units, empty when an error is expected
+		wantErr  string
+	}{
+		{value: "1", decimals: 18, want: "1000000000000000000"},
+		{value: "0.25", decimals: 18, want: "250000000000000000"},
+		{value: ".5", decimals: 2, want: "50"},
+		{value: "5.", decimals: 2, want: "500"},
+		{value: "1.5", decimals: 0, wantErr: "1 fractional digits given but only 0 decimals are supported"},
+		{value: "0.1234567", decimals: 6, wantErr: "7 fractional digits given but only 6 decimals are supported"},
+		{value: "0.123456", decimals: 6, want: "123456"},
+		{value: "1_000_000", decimals: 0, want: "1000000"},
+		{value: "1_000.000_5", decimals: 4, want: "10000005"},
+		{value: "_1000", decimals: 0, wantErr: "underscores must separate digits"},
+		{value: "1000_", decimals: 0, wantErr: "underscores must separate digits"},
+		{value: "1__000", decimals: 0, wantErr: "underscores must separate digits"},
+		{value: "1_.5", decimals: 1, wantErr: "underscores must separate digits"},
+		{value: "", decimals: 18, wantErr: "not a number"},
+		{value: ".", decimals: 18, wantErr: "not a number"},
+		{value: "-1", decimals: 18, wantErr: "not a non-negative decimal number"},
+		{value: "1e18", decimals: 18, wantErr: "not a non-negative decimal number"},
+		{value: "1.2.3", decimals: 18, wantErr: "not a non-negative decimal number"},
+		// Values far beyond the int64 and uint256 ranges are scaled exactly;
+		// ParseSupply is what enforces the uint256 bound.
+		{value: "99999999999999999999999999999999999999999999999999999999999999999999999999999999", decimals: 18,
+			want: "99999999999999999999999999999999999999999999999999999999999999999999999999999999" + strings.Repeat("0", 18)},
+	}
+	for _, tt := range tests {
+		got, err := ParseUnits(tt.value, tt.decimals)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseUnits(%q, %d) = %v, %v, want an error containing %q", tt.value, tt.decimals, got, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseUnits(%q, %d) failed: %v", tt.value, tt.decimals, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("ParseUnits(%q, %d) = %s, want %s", tt.value, tt.decimals, got, tt.want)
+		}
+	}
+}
+
+func TestParseSupplyUint256(t *testing.T) {
+	maxUint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	overflow := new(big.Int).Lsh(big.NewInt(1), 256)
+
+	// At 0 decimals the supply is already in base units.
+	got, err := ParseSupply(maxUint.String(), 0)
+	if err != nil {
+		t.Fatalf("ParseSupply(2^256-1, 0) failed: %v", err)
+	}
+	if got.Cmp(maxUint) != 0 {
+		t.Errorf("ParseSupply(2^256-1, 0) = %s, want %s", got, maxUint)
+	}
+	if _, err := ParseSupply(overflow.String(), 0); !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("ParseSupply(2^256, 0) = %v, want ErrInvalidParams", err)
+	}
+
+	// With decimals the whole-unit supply is scaled first, so the largest
+	// supply shrinks by 10^decimals; 2^256-1 base units is written with all
+	// of its digits split at the decimal point.
+	digits := maxUint.String()
+	whole, frac := digits[:len(digits)-18], digits[len(digits)-18:]
+	if got, err := ParseSupply(whole+"."+frac, 18); err != nil || got.Cmp(maxUint) != 0 {
+		t.Errorf("ParseSupply(%s.%s, 18) = %v, %v, want 2^256-1", whole, frac, got, err)
+	}
+	largest := new(big.Int).Quo(maxUint, new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+	if _, err := ParseSupply(largest.String(), 18); err != nil {
+		t.Errorf("ParseSupply(%s, 18) failed: %v", largest, err)
+	}
+	tooLarge := new(big.Int).Add(largest, big.NewInt(1))
+	_, err = ParseSupply(tooLarge.String(), 18)
+	if !errors.Is(err, ErrInvalidParams) {
+		t.Fatalf("ParseSupply(%s, 18) = %v, want ErrInvalidParams", tooLarge, err)
+	}
+	if !strings.Contains(err.Error(), "at 18 decimals, at most 1.16e+59 whole tokens fit") {
+		t.Errorf("ParseSupply(%s, 18) = %v, want the largest supply in the error", tooLarge, err)
+	}
+	// The same whole-unit supply fits without decimals.
+	if _, err := ParseSupply(tooLarge.String(), 0); err != nil {
+		t.Errorf("ParseSupply(%s, 0) failed: %v", tooLarge, err)
+	}
+}
+
+func TestCheckUint256(t *testing.T) {
+	maxUint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	if err := checkUint256(maxUint, 18); err != nil {
+		t.Errorf("checkUint256(2^256-1) = %v, want nil", err)
+	}
+	overflow := new(big.Int).Add(maxUint, big.NewInt(1))
+	for _, decimals := range []uint8{0, 6, 18} {
+		if err := checkUint256(overflow, decimals); !errors.Is(err, ErrInvalidParams) {
+			t.Errorf("checkUint256(2^256, %d) = %v, want ErrInvalidParams", decimals, err)
+		}
+	}
+}
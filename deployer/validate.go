@@ -0,0 +1,65 @@
+package deployer
+
+import (
+	"fmt"
+	"math/big"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSymbolLength is the longest symbol wallets and explorers reliably display.
+	MaxSymbolLength = 11
+	// MaxDecimals is the largest decimals value accepted without force.
+	MaxDecimals = 18
+)
+
+// ValidateParams checks the token metadata before anything is sent, so a
+// typo fails with a descriptive error instead of an odd deployment. With
+// force, decimals above MaxDecimals are allowed. Errors wrap
+// ErrInvalidParams.
+func ValidateParams(name, symbol string, decimals uint, supply *big.Int, force bool) error {
+	if err := ValidateName(name); err != nil {
+		return err
+	}
+	if err := ValidateSymbol(symbol); err != nil {
+		return err
+	}
+	if err := ValidateDecimals(decimals, force); err != nil {
+		return err
+	}
+	if supply == nil || supply.Sign() <= 0 {
+		return fmt.Errorf("%w: the total supply must be greater than zero", ErrInvalidParams)
+	}
+	return checkUint256(supply, uint8(decimals))
+}
+
+// ValidateName checks the token name.
+func ValidateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: the token name must not be empty", ErrInvalidParams)
+	}
+	return nil
+}
+
+// ValidateSymbol checks the token symbol.
+func ValidateSymbol(symbol string) error {
+	if symbol == "" {
+		return fmt.Errorf("%w: the token symbol must not be empty", ErrInvalidParams)
+	}
+	if n := utf8.RuneCountInString(symbol); n > MaxSymbolLength {
+		return fmt.Errorf("%w: the token symbol %q is %d characters long, at most %d are allowed", ErrInvalidParams, symbol, n, MaxSymbolLength)
+	}
+	return nil
+}
+
+// ValidateDecimals checks the token decimals, which must fit in a uint8 and,
+// unless force is set, not exceed MaxDecimals.
+func ValidateDecimals(decimals uint, force bool) error {
+	if decimals > 255 {
+		return fmt.Errorf("%w: decimals must fit in a uint8, got %d", ErrInvalidParams, decimals)
+	}
+	if decimals > MaxDecimals && !force {
+		return fmt.Errorf("%w: decimals of %d is unusually high, at most %d is expected (pass -force to deploy anyway)", ErrInvalidParams, decimals, MaxDecimals)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package deployer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestValidateParams(t *testing.T) {
+	supply := big.NewInt(1_000_000)
+	tests := []struct {
+		name     string
+		token    string
+		symbol   string
+		decimals uint
+		supply   *big.Int
+		force    bool
+		wantErr  string // substring of the error, empty for none
+	}{
+		{name: "valid", token: "My Token", symbol: "MTK", decimals: 18, supply: supply},
+		{name: "empty name", token: "", symbol: "MTK", decimals: 18, supply: supply, wantErr: "name must not be empty"},
+		{name: "empty symbol", token: "My Token", symbol: "", decimals: 18, supply: supply, wantErr: "symbol must not be empty"},
+		{name: "longest symbol", token: "My Token", symbol: "ABCDEFGHIJK", decimals: 18, supply: supply},
+		{name: "symbol too long", token: "My Token", symbol: "ABCDEFGHIJKL", decimals: 18, supply: supply, wantErr: "12 characters long"},
+		{name: "symbol counted in characters", token: "My Token", symbol: "ÄÖÜÄÖÜÄÖÜÄÖ", decimals: 18, supply: supply},
+		{name: "zero decimals", token: "My Token", symbol: "MTK", decimals: 0, supply: supply},
+		{name: "decimals too high", token: "My Token", symbol: "MTK", decimals: 19, supply: supply, wantErr: "unusually high"},
+		{name: "decimals too high with force", token: "My Token", symbol: "MTK", decimals: 19, supply: supply, force: true},
+		{name: "decimals beyond uint8 with force", token: "My Token", symbol: "MTK", decimals: 256, supply: supply, force: true, wantErr: "must fit in a uint8"},
+		{name: "zero supply", token: "My Token", symbol: "MTK", decimals: 18, supply: big.NewInt(0), wantErr: "greater than zero"},
+		{name: "negative supply", token: "My Token", symbol: "MTK", decimals: 18, supply: big.NewInt(-1), wantErr: "greater than zero"},
+		{name: "no supply", token: "My Token", symbol: "MTK", decimals: 18, supply: nil, wantErr: "greater than zero"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(tt.token, tt.symbol, tt.decimals, tt.supply, tt.force)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateParams() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("ValidateParams() = %v, want an error containing %q", err, tt.wantErr)
+			}
+			if !errors.Is(err, ErrInvalidParams) {
+				t.Errorf("ValidateParams() = %v, want it to wrap ErrInvalidParams", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Compiles contracts/ with the compiler release and settings of
+// contracts/solc.json, which the erc20 command also reads to rebuild the
+// verification input from the sources. Every compile copies the artifacts of
+// the contracts the command deploys, and the build-info, to
+// contracts/artifacts, where the command embeds them when it is built.
+const fs = require("fs");
+const path = require("path");
+const { task } = require("hardhat/config");
+
+const solc = require("./contracts/solc.json");
+
+const artifactsDir = path.join(__dirname, "contracts", "artifacts");
+
+// The contracts the command deploys, each copied to
+// contracts/artifacts/<name>.json. A contract is found by its name or, for a
+// file holding a single contract such as contracts/ERC20Token.sol, by the
+// file name.
+const deployed = new Set([
+  "ERC20Token",
+  "MintableERC20Token",
+  "BurnableERC20Token",
+  "PausableERC20Token",
+  "CappedERC20Token",
+  "PermitERC20Token",
+  "VotesERC20Token",
+  "ERC777Token",
+  "ERC1363Token",
+  "InitializableERC20Token",
+  "UUPSERC20Token",
+  "ERC1967Proxy",
+  "TransparentUpgradeableProxy",
+  "TaxERC20Token",
+  "RestrictedERC20Token",
+  "WrappedNativeToken",
+]);
+
+task("compile", async (args, hre, runSuper) => {
+  await runSuper(args);
+
+  for (const name of await hre.artifacts.getAllFullyQualifiedNames()) {
+    const artifact = await hre.artifacts.readArtifact(name);
+    const base = path.basename(artifact.sourceName, ".sol");
+    let target;
+    if (deployed.has(artifact.contractName)) {
+      target = artifact.contractName;
+    } else if (artifact.sourceName === `contracts/${base}.sol` && deployed.has(base)) {
+      target = base;
+    } else {
+      continue;
+    }
+    fs.writeFileSync(
+      path.join(artifactsDir, `${target}.json`),
+      JSON.stringify({ ...artifact, contractName: target }, null, 2) + "\n",
+    );
+  }
+
+  const buildInfo = path.join(hre.config.paths.artifacts, "build-info");
+  fs.rmSync(path.join(artifactsDir, "build-info"), { recursive: true, force: true });
+  fs.cpSync(buildInfo, path.join(artifactsDir, "build-info"), { recursive: true });
+});
+
+module.exports = {
+  solidity: {
+    version: solc.version.split("+")[0],
+    settings: solc.settings,
+  },
+  paths: {
+    sources: "./contracts",
+    artifacts: "./build/artifacts",
+    cache: "./build/cache",
+  },
+};